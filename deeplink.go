@@ -0,0 +1,18 @@
+package main
+
+import (
+	"strings"
+
+	"skillDar/pkg/router"
+)
+
+// linksFromArgs returns the command line arguments that look like deep links
+func linksFromArgs(args []string) []string {
+	var links []string
+	for _, arg := range args {
+		if strings.HasPrefix(arg, router.Scheme+"://") || strings.HasPrefix(arg, "https://") {
+			links = append(links, arg)
+		}
+	}
+	return links
+}
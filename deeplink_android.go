@@ -0,0 +1,92 @@
+//go:build android
+
+package main
+
+/*
+#include <jni.h>
+#include <stdint.h>
+#include <stdlib.h>
+#include <string.h>
+
+// intentLink returns a copy of the data URI of the activity's intent, or NULL
+// when there is none. It clears the data so the link is opened only once.
+static char* intentLink(uintptr_t jniEnv, uintptr_t ctx) {
+	JNIEnv* env = (JNIEnv*)jniEnv;
+	jobject activity = (jobject)ctx;
+	char* link = NULL;
+
+	jclass activityClass = (*env)->GetObjectClass(env, activity);
+	jmethodID getIntent = (*env)->GetMethodID(env, activityClass, "getIntent", "()Landroid/content/Intent;");
+	jobject intent = getIntent ? (*env)->CallObjectMethod(env, activity, getIntent) : NULL;
+	if ((*env)->ExceptionCheck(env) || intent == NULL) {
+		(*env)->ExceptionClear(env);
+		return NULL;
+	}
+
+	jclass intentClass = (*env)->GetObjectClass(env, intent);
+	jmethodID getDataString = (*env)->GetMethodID(env, intentClass, "getDataString", "()Ljava/lang/String;");
+	jmethodID setData = (*env)->GetMethodID(env, intentClass, "setData", "(Landroid/net/Uri;)Landroid/content/Intent;");
+	jstring data = getDataString ? (jstring)(*env)->CallObjectMethod(env, intent, getDataString) : NULL;
+	if ((*env)->ExceptionCheck(env)) {
+		(*env)->ExceptionClear(env);
+		return NULL;
+	}
+	if (data != NULL) {
+		const char* chars = (*env)->GetStringUTFChars(env, data, NULL);
+		link = strdup(chars);
+		(*env)->ReleaseStringUTFChars(env, data, chars);
+		if (setData) {
+			(*env)->CallObjectMethod(env, intent, setData, NULL);
+		}
+		(*env)->ExceptionClear(env);
+	}
+	return link;
+}
+*/
+import "C"
+
+import (
+	"unsafe"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/driver"
+)
+
+// Links reach Android apps as the data of the intent that starts the
+// activity, see the intent filters in AndroidManifest.xml. An activity
+// started for a link while the app runs becomes the current context when
+// it resumes, so the intent is read at launch and whenever the app comes
+// back to the foreground.
+
+// forwardLaunchLinks does nothing on Android, the system runs a single process
+func forwardLaunchLinks(fyne.App) bool {
+	return false
+}
+
+// receiveLinks opens the link of the intent the app was started with and of
+// later intents. open is called on the UI thread.
+func receiveLinks(a fyne.App, w fyne.Window, open func(link string)) {
+	native, ok := w.(driver.NativeWindow)
+	if !ok {
+		fyne.LogError("Deep links are disabled, the window has no native context", nil)
+		return
+	}
+	check := func() {
+		var link string
+		native.RunNative(func(context any) {
+			ctx, ok := context.(*driver.AndroidWindowContext)
+			if !ok {
+				return
+			}
+			if data := C.intentLink(C.uintptr_t(ctx.Env), C.uintptr_t(ctx.Ctx)); data != nil {
+				link = C.GoString(data)
+				C.free(unsafe.Pointer(data))
+			}
+		})
+		if link != "" {
+			open(link)
+		}
+	}
+	check()
+	a.Lifecycle().SetOnEnteredForeground(check)
+}
@@ -0,0 +1,134 @@
+//go:build !android && !ios
+
+package main
+
+import (
+	"bufio"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"fyne.io/fyne/v2"
+)
+
+// The OS starts a new process for every skilldar:// link. That process hands
+// its links to the running instance and exits. The running instance listens
+// on a Unix socket in the user's app storage, which browsers cannot reach,
+// and only takes links after the random token it wrote next to the socket,
+// readable by the user only.
+const (
+	linkSocketName = "links.sock"
+	linkTokenName  = "links.token"
+
+	maxLinksPerConnection = 16
+	linkReadTimeout       = 5 * time.Second
+)
+
+// linkPaths returns where the running instance keeps its socket and token
+func linkPaths(a fyne.App) (socket, token string) {
+	dir := a.Storage().RootURI().Path()
+	return filepath.Join(dir, linkSocketName), filepath.Join(dir, linkTokenName)
+}
+
+// forwardLaunchLinks hands the links the process was started with to the
+// running instance. It reports whether they were handed over, the process
+// then exits without building its UI.
+func forwardLaunchLinks(a fyne.App) bool {
+	links := linksFromArgs(os.Args[1:])
+	if len(links) == 0 {
+		return false
+	}
+	socket, tokenPath := linkPaths(a)
+	token, err := os.ReadFile(tokenPath)
+	if err != nil {
+		return false
+	}
+	conn, err := net.DialTimeout("unix", socket, time.Second)
+	if err != nil {
+		return false
+	}
+	defer conn.Close()
+
+	w := bufio.NewWriter(conn)
+	fmt.Fprintln(w, strings.TrimSpace(string(token)))
+	for _, link := range links {
+		fmt.Fprintln(w, link)
+	}
+	return w.Flush() == nil
+}
+
+// receiveLinks opens the links the app was launched with and the links
+// handed over by later launches. open is called on the UI thread.
+func receiveLinks(a fyne.App, _ fyne.Window, open func(link string)) {
+	for _, link := range linksFromArgs(os.Args[1:]) {
+		open(link)
+	}
+
+	listener, token, err := listenForLinks(a)
+	if err != nil {
+		fyne.LogError("Deep links from other launches are disabled", err)
+		return
+	}
+	go serveLinks(listener, token, open)
+}
+
+// listenForLinks creates the socket and a new token for it
+func listenForLinks(a fyne.App) (net.Listener, string, error) {
+	socket, tokenPath := linkPaths(a)
+	if err := os.MkdirAll(filepath.Dir(socket), 0o700); err != nil {
+		return nil, "", err
+	}
+	if conn, err := net.DialTimeout("unix", socket, time.Second); err == nil {
+		conn.Close()
+		return nil, "", errors.New("another instance is receiving links")
+	}
+	// Left behind by an instance that did not exit cleanly
+	_ = os.Remove(socket)
+
+	raw := make([]byte, 32)
+	if _, err := rand.Read(raw); err != nil {
+		return nil, "", err
+	}
+	token := hex.EncodeToString(raw)
+	_ = os.Remove(tokenPath) // WriteFile keeps the mode of an existing file
+	if err := os.WriteFile(tokenPath, []byte(token), 0o600); err != nil {
+		return nil, "", err
+	}
+
+	listener, err := net.Listen("unix", socket)
+	if err != nil {
+		return nil, "", err
+	}
+	_ = os.Chmod(socket, 0o600)
+	return listener, token, nil
+}
+
+// serveLinks opens the links sent by connections that know the token
+func serveLinks(listener net.Listener, token string, open func(link string)) {
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		go func() {
+			defer conn.Close()
+			_ = conn.SetReadDeadline(time.Now().Add(linkReadTimeout))
+			scanner := bufio.NewScanner(conn)
+			if !scanner.Scan() || subtle.ConstantTimeCompare(scanner.Bytes(), []byte(token)) != 1 {
+				fyne.LogError("Ignoring links from a sender without the token", nil)
+				return
+			}
+			for n := 0; n < maxLinksPerConnection && scanner.Scan(); n++ {
+				link := scanner.Text()
+				fyne.Do(func() { open(link) })
+			}
+		}()
+	}
+}
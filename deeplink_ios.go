@@ -0,0 +1,14 @@
+//go:build ios
+
+package main
+
+import "fyne.io/fyne/v2"
+
+// forwardLaunchLinks does nothing on iOS, the system runs a single instance
+func forwardLaunchLinks(fyne.App) bool {
+	return false
+}
+
+// receiveLinks does nothing yet on iOS, which delivers links to the app
+// delegate that the Fyne driver does not expose
+func receiveLinks(fyne.App, fyne.Window, func(link string)) {}
@@ -5,6 +5,7 @@ go 1.24.3
 require (
 	fyne.io/fyne/v2 v2.7.1
 	fyne.io/x/fyne v0.0.0-20250910205345-ecc79984d005
+	golang.org/x/image v0.24.0
 )
 
 require (
@@ -34,7 +35,6 @@ require (
 	github.com/srwiley/rasterx v0.0.0-20220730225603-2ab79fcdd4ef // indirect
 	github.com/stretchr/testify v1.11.1 // indirect
 	github.com/yuin/goldmark v1.7.8 // indirect
-	golang.org/x/image v0.24.0 // indirect
 	golang.org/x/net v0.35.0 // indirect
 	golang.org/x/sys v0.30.0 // indirect
 	golang.org/x/text v0.22.0 // indirect
@@ -1,5 +1,3 @@
-//go:generate fyne bundle -o bundle.go assets
-
 package main
 
 import (
@@ -8,110 +6,332 @@ import (
 	"fyne.io/fyne/v2"
 	"fyne.io/fyne/v2/app"
 	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/driver/mobile"
+	"fyne.io/fyne/v2/lang"
 	"fyne.io/fyne/v2/theme"
 	"fyne.io/fyne/v2/widget"
 
+	"skillDar/pkg/assets"
+	"skillDar/pkg/i18n"
+	"skillDar/pkg/money"
+	"skillDar/pkg/router"
 	skilltheme "skillDar/pkg/theme"
 	uiscreen "skillDar/pkg/ui"
 )
 
 // AppState manages navigation and theme across screens
 type AppState struct {
-	app               fyne.App
-	window            fyne.Window
-	isDarkTheme       bool
-	screens           map[string]fyne.CanvasObject
-	icons             map[string]fyne.Resource    // Map of all app icons
-	userRole          string                      // "client" or "worker"
-	screenHistory     []string                    // Navigation history
-	currentWorker     *uiscreen.WorkerProfile     // Current worker being viewed
-	connectionManager *uiscreen.ConnectionManager // Connection status manager
-	currentContent    *fyne.Container             // Current screen content container
-}
-
-// ShowScreen displays a screen by name with the top bar
-func (as *AppState) ShowScreen(screenName string) {
-	if screen, exists := as.screens[screenName]; exists {
-		// Add to history (avoid duplicates)
-		if len(as.screenHistory) == 0 || as.screenHistory[len(as.screenHistory)-1] != screenName {
-			as.screenHistory = append(as.screenHistory, screenName)
-		}
+	app           fyne.App
+	window        fyne.Window
+	screens       map[router.Name]func(uiscreen.AppState) fyne.CanvasObject // Screen constructors
+	built         map[string]fyne.CanvasObject                              // Screens built so far, by route
+	userRole      string                                                    // "client" or "worker"
+	router        *router.Router                                            // Back stack
+	loggedIn      bool                                                      // Set once login completes
+	pendingRoute  *router.Route                                             // Deep link waiting for login
+	workers       map[string]uiscreen.WorkerProfile                         // Workers already loaded, by ID
+	notifications *uiscreen.NotificationManager                             // Notifications at the top of the window
+	currentScreen fyne.CanvasObject                                         // Screen on top of the back stack
+	screenStack   *fyne.Container                                           // All built screens, only the current one visible
+	favorites     *uiscreen.FavoritesStore                                  // Saved workers, synced and cached offline
+	profile       *uiscreen.ProfileStore                                    // Signed-in user's profile
+	avatarButton  *uiscreen.AvatarButton                                    // User's picture in the top bar
+	addresses     *uiscreen.AddressStore                                    // Saved addresses, cached offline
+	settings      *uiscreen.SettingsStore                                   // User settings, kept in preferences
+	appliedTheme  themeChoice                                               // Settings the current theme was built from
+	locale        string                                                    // Language the screens are built in
+}
+
+// ownBackButton lists the screens that draw a back button in their own header
+var ownBackButton = map[router.Name]bool{
+	router.Profile:       true,
+	router.WorkerProfile: true,
+}
+
+// Navigate shows a route on top of the back stack
+func (as *AppState) Navigate(route router.Route) {
+	as.saveViewState()
+	as.router.Push(route)
+}
+
+// ResetTo clears the back stack and shows a route, e.g. after login
+func (as *AppState) ResetTo(route router.Route) {
+	as.router.Reset(route)
+}
+
+// GoBack navigates to the previous screen, unless the screen asks to stay
+func (as *AppState) GoBack() {
+	if as.confirmLeave() {
+		as.router.Pop()
+	}
+}
 
-		// Create screen content with notification area
-		as.currentContent = container.NewBorder(
-			nil,    // Top
-			nil,    // Bottom
-			nil,    // Left
-			nil,    // Right
-			screen, // Center
-		)
-
-		// Wrap screen with top bar and notification area
-		layout := container.NewBorder(
-			container.NewVBox(
-				as.connectionManager.GetContainer(), // Connection notifications
-				as.createTopBar(),                   // Top (back button)
-			),
-			nil,               // Bottom
-			nil,               // Left
-			nil,               // Right
-			as.currentContent, // Center (screen content)
-		)
-		as.window.SetContent(layout)
+// confirmLeave reports whether the current screen can be left right away.
+// A screen with unsaved edits asks first and goes back itself if confirmed.
+func (as *AppState) confirmLeave() bool {
+	guard, ok := as.currentScreen.(uiscreen.LeaveGuard)
+	if !ok {
+		return true
+	}
+	return guard.ConfirmLeave(func() { as.router.Pop() })
+}
+
+// OpenLink shows the screen a deep link points to.
+// Links to screens that need a signed-in user wait until login completes.
+func (as *AppState) OpenLink(link string) {
+	route, err := router.ParseLink(link)
+	if err != nil {
+		fyne.LogError("Ignoring link "+link, err)
+		return
+	}
+	if route.Name.RequiresAuth() && !as.loggedIn {
+		as.pendingRoute = &route
+		return
 	}
+	as.Navigate(route)
+}
+
+// CompleteLogin shows the main screen, then the deep link that waited for login
+func (as *AppState) CompleteLogin() {
+	as.loggedIn = true
+	// Addresses are cached per user, so they wait for the user's ID
+	as.profile.Load(func(err error) {
+		if err == nil {
+			as.addresses.SetUser(as.profile.Profile().ID)
+			as.addresses.Sync(nil)
+		}
+	})
+	as.ResetTo(router.To(router.Main))
+	if as.pendingRoute != nil {
+		route := *as.pendingRoute
+		as.pendingRoute = nil
+		as.Navigate(route)
+	}
+}
+
+// Logout forgets the signed-in user's data, including the addresses cached
+// for offline use, and goes back to the welcome screen
+func (as *AppState) Logout() {
+	as.loggedIn = false
+	as.pendingRoute = nil
+	as.addresses.Clear()
+	as.profile.Set(uiscreen.UserProfile{})
+	as.destroyAll()
+	as.ResetTo(router.To(router.Welcome))
 }
 
 // ShowWorkerProfile displays a worker's profile screen
 func (as *AppState) ShowWorkerProfile(worker uiscreen.WorkerProfile) {
-	as.currentWorker = &worker
+	as.workers[worker.ID] = worker
+	as.Navigate(router.WorkerProfileRoute(worker.ID))
+}
 
-	// Create the worker profile screen
-	profileScreen := uiscreen.CreateWorkerProfileScreen(as, worker)
+// ShowBooking displays the booking form for a worker
+func (as *AppState) ShowBooking(worker uiscreen.WorkerProfile) {
+	as.workers[worker.ID] = worker
+	as.Navigate(router.BookingRoute(worker.ID))
+}
 
-	// Add to history
-	screenName := "worker_profile"
-	if len(as.screenHistory) == 0 || as.screenHistory[len(as.screenHistory)-1] != screenName {
-		as.screenHistory = append(as.screenHistory, screenName)
+// screenFor returns the screen for a route, building it on first use.
+// It returns nil if the route is unknown.
+func (as *AppState) screenFor(route router.Route) fyne.CanvasObject {
+	key := route.String()
+	if screen, ok := as.built[key]; ok {
+		return screen
 	}
+	screen := as.buildScreen(route)
+	if screen != nil {
+		as.built[key] = screen
+	}
+	return screen
+}
 
-	// Create content with notification area
-	as.currentContent = container.NewBorder(
-		nil,           // Top
-		nil,           // Bottom
-		nil,           // Left
-		nil,           // Right
-		profileScreen, // Center
-	)
+// buildScreen constructs the screen for a route
+func (as *AppState) buildScreen(route router.Route) fyne.CanvasObject {
+	var known *uiscreen.WorkerProfile
+	if worker, ok := as.workers[route.WorkerID()]; ok {
+		known = &worker
+	}
+
+	switch route.Name {
+	case router.Order:
+		return uiscreen.CreateOrderScreen(as, route.OrderID())
+	case router.Chat:
+		return uiscreen.CreateChatScreen(as, route.ChatID())
+	case router.WorkerProfile:
+		return uiscreen.LoadWorkerScreen(as, route.WorkerID(), known, func(w uiscreen.WorkerProfile) fyne.CanvasObject {
+			return uiscreen.CreateWorkerProfileScreen(as, w)
+		})
+	case router.Booking:
+		return uiscreen.LoadWorkerScreen(as, route.WorkerID(), known, func(w uiscreen.WorkerProfile) fyne.CanvasObject {
+			return uiscreen.CreateBookingScreen(as, w)
+		})
+	}
+	if create, ok := as.screens[route.Name]; ok {
+		return create(as)
+	}
+	return nil
+}
+
+// releaseScreens destroys the screens built for routes with parameters,
+// e.g. a worker profile, once they are no longer on the back stack.
+// Screens without parameters stay built for the next visit.
+func (as *AppState) releaseScreens() {
+	onStack := map[string]bool{}
+	for _, route := range as.router.Stack() {
+		onStack[route.String()] = true
+	}
+	for key, screen := range as.built {
+		if onStack[key] || as.screens[router.Name(key)] != nil {
+			continue
+		}
+		destroyScreen(screen)
+		as.screenStack.Remove(screen)
+		delete(as.built, key)
+	}
+}
 
-	// Show with notification area at top
+// destroyAll drops every built screen, e.g. when the user role changes
+func (as *AppState) destroyAll() {
+	if s, ok := as.currentScreen.(uiscreen.ScreenLifecycle); ok {
+		s.OnHide()
+	}
+	as.currentScreen = nil
+	for _, screen := range as.built {
+		destroyScreen(screen)
+	}
+	as.built = map[string]fyne.CanvasObject{}
+	as.screenStack.RemoveAll()
+}
+
+func destroyScreen(screen fyne.CanvasObject) {
+	if s, ok := screen.(uiscreen.ScreenLifecycle); ok {
+		s.OnDestroy()
+	}
+}
+
+// showRoute displays the screen on top of the back stack with the top bar
+func (as *AppState) showRoute(t router.Transition) {
+	screen := as.screenFor(t.To.Route)
+	if screen == nil {
+		fyne.LogError("Unknown route "+t.To.Route.String(), nil)
+		as.router.Pop()
+		return
+	}
+	// Showing the current screen again, e.g. a link to it, is not a new
+	// visit, so it does not start polling twice
+	changed := as.currentScreen != screen
+	if s, ok := as.currentScreen.(uiscreen.ScreenLifecycle); ok && changed {
+		s.OnHide()
+	}
+	as.currentScreen = screen
+
+	// Built screens stay in the window, hidden, so theme changes reach them too
+	found := false
+	for _, obj := range as.screenStack.Objects {
+		if obj == screen {
+			found = true
+			obj.Show()
+		} else {
+			obj.Hide()
+		}
+	}
+	if !found {
+		as.screenStack.Add(screen)
+	}
+
+	top := container.NewVBox(as.notifications.Container()) // Notifications
+	if !ownBackButton[t.To.Route.Name] {
+		top.Add(as.createTopBar()) // Top (back button)
+	}
+
+	// Wrap screen with top bar and notification area
 	layout := container.NewBorder(
-		as.connectionManager.GetContainer(), // Connection notifications
-		nil,                                 // Bottom
-		nil,                                 // Left
-		nil,                                 // Right
-		as.currentContent,                   // Center
+		top,
+		nil,            // Bottom
+		nil,            // Left
+		nil,            // Right
+		as.screenStack, // Center (screen content)
 	)
 	as.window.SetContent(layout)
+
+	if s, ok := screen.(uiscreen.ScreenLifecycle); ok && changed {
+		s.OnShow()
+	}
+	if t.Action == router.Pop {
+		restoreViewState(screen, t.To.View)
+	}
+	as.releaseScreens()
+}
+
+// saveViewState records the current screen's tab and scroll offset before leaving it
+func (as *AppState) saveViewState() {
+	if as.currentScreen == nil {
+		return
+	}
+	if s, ok := as.currentScreen.(uiscreen.ViewStateful); ok {
+		as.router.SaveViewState(s.ViewState())
+		return
+	}
+	if scroll := findScroll(as.currentScreen); scroll != nil {
+		as.router.SaveViewState(router.ViewState{ScrollX: scroll.Offset.X, ScrollY: scroll.Offset.Y})
+	}
+}
+
+// restoreViewState puts a screen back the way the user left it
+func restoreViewState(screen fyne.CanvasObject, view router.ViewState) {
+	if s, ok := screen.(uiscreen.ViewStateful); ok {
+		s.RestoreViewState(view)
+		return
+	}
+	if scroll := findScroll(screen); scroll != nil {
+		scroll.Offset = fyne.NewPos(view.ScrollX, view.ScrollY)
+		scroll.Refresh()
+	}
+}
+
+// findScroll returns the first scroll container of a screen
+func findScroll(obj fyne.CanvasObject) *container.Scroll {
+	switch o := obj.(type) {
+	case *container.Scroll:
+		return o
+	case *uiscreen.Screen:
+		return findScroll(o.Content)
+	case *fyne.Container:
+		for _, child := range o.Objects {
+			if scroll := findScroll(child); scroll != nil {
+				return scroll
+			}
+		}
+	}
+	return nil
+}
+
+// handleKey maps the Android back key and Escape to back navigation
+func (as *AppState) handleKey(ev *fyne.KeyEvent) {
+	if ev.Name != mobile.KeyBack && ev.Name != fyne.KeyEscape {
+		return
+	}
+	if !as.confirmLeave() {
+		return
+	}
+	if as.router.Pop() || ev.Name != mobile.KeyBack {
+		return
+	}
+	// Nothing to go back to, let the system handle it (leaves the app on Android)
+	if d, ok := fyne.CurrentApp().Driver().(interface{ GoBack() }); ok {
+		d.GoBack()
+	}
 }
 
 // createTopBar builds the top navigation bar with back button only
 func (as *AppState) createTopBar() *fyne.Container {
-	// Back button (only show if we're past the main screen)
 	var backBtn *widget.Button
-	currentScreen := ""
-	if len(as.screenHistory) > 0 {
-		currentScreen = as.screenHistory[len(as.screenHistory)-1]
-	}
-
-	// Show back button only if we're past the main screen (not on welcome, login, or main)
-	showBackButton := len(as.screenHistory) > 1 &&
-		currentScreen != "welcome" &&
-		currentScreen != "login" &&
-		currentScreen != "main"
 
-	if showBackButton {
-		backBtn = widget.NewButton("←", func() {
-			as.goBack()
+	// Show back button only if there is a previous screen (not on welcome, login, or main)
+	if as.router.CanGoBack() {
+		backBtn = widget.NewButtonWithIcon("", uiscreen.BackIcon(), func() {
+			as.GoBack()
 		})
 	} else {
 		// Empty placeholder if no back button needed
@@ -120,63 +340,162 @@ func (as *AppState) createTopBar() *fyne.Container {
 		backBtn.Hide() // Hide it completely
 	}
 
+	// Signed-in users can open their profile from any screen
+	var avatar fyne.CanvasObject
+	if as.loggedIn {
+		if as.avatarButton == nil {
+			as.avatarButton = uiscreen.NewAvatarButton(as)
+		}
+		avatar = as.avatarButton
+	}
+
+	// Back button at the reading start, avatar at the end
+	leading, trailing := fyne.CanvasObject(backBtn), avatar
+	if i18n.RightToLeft() {
+		leading, trailing = trailing, leading
+	}
 	return container.NewBorder(
 		nil, nil,
-		backBtn, // Left
-		nil,     // Right (theme toggle moved to profile screen)
-		nil,     // Center
+		leading,
+		trailing,
+		nil, // Center
 	)
 }
 
-// goBack navigates to the previous screen
-func (as *AppState) goBack() {
-	if len(as.screenHistory) > 1 {
-		// Remove current screen
-		as.screenHistory = as.screenHistory[:len(as.screenHistory)-1]
-		// Get previous screen
-		previousScreen := as.screenHistory[len(as.screenHistory)-1]
-		// Remove it from history before showing (ShowScreen will re-add it)
-		as.screenHistory = as.screenHistory[:len(as.screenHistory)-1]
-		// Show previous screen
-		as.ShowScreen(previousScreen)
+// ToggleTheme switches between light and dark theme, the choice is saved in the settings
+func (as *AppState) ToggleTheme() {
+	if as.IsDarkTheme() {
+		as.settings.SetTheme(uiscreen.ThemeLight)
+	} else {
+		as.settings.SetTheme(uiscreen.ThemeDark)
 	}
 }
 
-// toggleTheme switches between light and dark theme
-func (as *AppState) ToggleTheme() {
-	as.isDarkTheme = !as.isDarkTheme
-	fmt.Println("Theme toggled. isDarkTheme:", as.isDarkTheme)
+// themeVariant returns the variant of the theme mode in the settings
+func (as *AppState) themeVariant() fyne.ThemeVariant {
+	switch as.settings.Theme() {
+	case uiscreen.ThemeDark:
+		return theme.VariantDark
+	case uiscreen.ThemeLight:
+		return theme.VariantLight
+	}
+	return as.app.Settings().ThemeVariant() // Follow the system
+}
+
+// themeChoice holds the settings a theme is built from
+type themeChoice struct {
+	mode         uiscreen.ThemeMode
+	arabic       bool // Arabic font
+	highContrast bool
+	accent       skilltheme.Accent
+}
 
-	variant := theme.VariantLight
-	if as.isDarkTheme {
-		variant = theme.VariantDark
+// applyTheme installs the theme for the appearance and language settings.
+// Fyne then refreshes every widget in the window, so screens pick up the
+// new colors without being rebuilt. A system following theme is switched
+// by Fyne itself when the system setting changes.
+func (as *AppState) applyTheme() {
+	choice := themeChoice{
+		mode:         as.settings.Theme(),
+		arabic:       i18n.IsRightToLeft(as.locale),
+		highContrast: as.settings.HighContrast(),
+		accent:       as.settings.Accent(),
+	}
+	if choice == as.appliedTheme {
+		return
+	}
+	as.appliedTheme = choice
+
+	options := []skilltheme.Option{skilltheme.WithAccent(choice.accent)}
+	if choice.arabic {
+		options = append(options, skilltheme.WithArabicText())
+	}
+	if choice.highContrast {
+		options = append(options, skilltheme.WithHighContrast())
+	}
+	if choice.mode == uiscreen.ThemeSystem {
+		as.app.Settings().SetTheme(skilltheme.NewSystemSkillKonnectTheme(options...))
+		return
+	}
+	as.app.Settings().SetTheme(skilltheme.NewSkillKonnectTheme(as.themeVariant(), options...))
+}
+
+// applyLanguage switches the app to the language setting, or to the
+// device language when following the system. Screens are built with their
+// texts and reading direction, so built screens are dropped and the
+// current one is built again.
+func (as *AppState) applyLanguage() {
+	locale := i18n.Detect(as.settings.Language(), lang.SystemLocale().String())
+	if locale == as.locale {
+		return
+	}
+	as.locale = locale
+	i18n.SetLocale(locale)
+	money.SetLocale(locale)
+
+	if as.screenStack == nil {
+		return // Starting up, no screen built yet
+	}
+	as.destroyAll()
+	if current, ok := as.router.Current(); ok {
+		as.showRoute(router.Transition{Action: router.Replace, From: current.Route, To: current})
 	}
-	as.app.Settings().SetTheme(skilltheme.NewSkillKonnectTheme(variant))
-	as.window.Content().Refresh()
 }
 
 // getThemeIcon returns the appropriate icon for current theme
 func (as *AppState) GetThemeIcon() fyne.Resource {
-	if as.isDarkTheme {
-		return as.icons["lightTheme"]
-	}
-	return as.icons["darkTheme"]
+	return as.GetImage(assets.ThemeToggle)
 }
 
 // IsDarkTheme returns whether dark theme is currently active
 func (as *AppState) IsDarkTheme() bool {
-	return as.isDarkTheme
+	return as.themeVariant() == theme.VariantDark
 }
 
-// GetImage returns an image resource by name
-func (as *AppState) GetImage(name string) fyne.Resource {
-	return as.icons[name]
+// GetWindow returns the main window, used as parent for dialogs
+func (as *AppState) GetWindow() fyne.Window {
+	return as.window
 }
 
-// SetUserRole sets the user role (client or worker)
+// GetImage returns a bundled image for the current theme and screen density
+func (as *AppState) GetImage(key assets.Key) fyne.Resource {
+	return assets.Resource(key, as.themeVariant(), as.window.Canvas().Scale())
+}
+
+// SetUserRole sets the user role (client or worker).
+// Built screens are dropped so they are rebuilt for the new role, and the main screen is shown.
 func (as *AppState) SetUserRole(role string) {
+	if role == as.userRole {
+		return
+	}
 	as.userRole = role
 	fmt.Println("User role set to:", role)
+
+	as.destroyAll()
+	as.ResetTo(router.To(router.Main))
+}
+
+// registerScreens registers the constructors of all named screens.
+// Screens are built when first shown, see screenFor.
+func (as *AppState) registerScreens() {
+	as.screens = map[router.Name]func(uiscreen.AppState) fyne.CanvasObject{
+		router.Welcome:           uiscreen.CreateWelcomeScreen,
+		router.Login:             uiscreen.CreateLoginScreen,
+		router.Main:              uiscreen.CreateMainScreen,
+		router.Profile:           uiscreen.CreateProfileScreen,
+		router.EditProfileClient: uiscreen.CreateEditProfileClientScreen,
+		router.SavedWorkers:      uiscreen.CreateSavedWorkersScreen,
+		router.Addresses:         uiscreen.CreateAddressesScreen,
+		router.Settings:          uiscreen.CreateSettingsScreen,
+		router.Availability:      uiscreen.CreateAvailabilityScreen,
+		router.EditProfileWorker: uiscreen.CreateEditProfileWorkerScreen,
+		router.Verification:      uiscreen.CreateVerificationScreen,
+		router.Earnings:          uiscreen.CreateEarningsScreen,
+		router.Wallet:            uiscreen.CreateWalletScreen,
+		router.Referral:          uiscreen.CreateReferralScreen,
+	}
+	as.built = map[string]fyne.CanvasObject{}
+	as.screenStack = container.NewStack()
 }
 
 // GetUserRole returns the current user role
@@ -184,66 +503,90 @@ func (as *AppState) GetUserRole() string {
 	return as.userRole
 }
 
-// ShowConnectionError displays a connection error notification
+// ShowConnectionError displays a connection error notification,
+// replacing the connection status shown before
 func (as *AppState) ShowConnectionError(status uiscreen.ConnectionStatus, message string) {
-	// Show notification (manual dismiss required)
-	as.connectionManager.ShowNotification(status, message, 0)
+	as.notifications.Show(status.Notification(message))
 }
 
 // HideConnectionError hides the connection error notification
 func (as *AppState) HideConnectionError() {
-	as.connectionManager.HideNotification()
+	as.notifications.DismissKey(uiscreen.ConnectionNotificationKey)
 }
 
-// initializeIcons creates and returns the map of all app icons
-func initializeIcons() map[string]fyne.Resource {
-	return map[string]fyne.Resource{
-		"lightTheme":        resourceActiverPng,    // Light theme = active/on icon
-		"darkTheme":         resourceDeactivatePng, // Dark theme = deactivate/off icon
-		"logoImage":         resourceSkillDarLogoPng,
-		"plumbing":          resourcePlumberIcoPng,
-		"electricity":       resourceElectricienIcoPng,
-		"painting":          resourcePaintingIcoPng,
-		"acFixing":          resourceACrepairePng,
-		"homeCleaning":      resourceHomeCleaningPng,
-		"smallRepairs":      resourceSmallrepairehandymanPng,
-		"furnitureAssembly": resourceFurnitureassemblyPng,
-		"waterLeakage":      resourceWaterleakagePng,
-		"applianceRepair":   resourceAppliancerepairePng,
-		"locksmith":         resourceLocksmithPng,
-	}
+// Notifications returns the notification manager
+func (as *AppState) Notifications() *uiscreen.NotificationManager {
+	return as.notifications
+}
+
+// Favorites returns the saved workers store
+func (as *AppState) Favorites() *uiscreen.FavoritesStore {
+	return as.favorites
+}
+
+// Addresses returns the saved addresses store
+func (as *AppState) Addresses() *uiscreen.AddressStore {
+	return as.addresses
+}
+
+// Settings returns the user settings store
+func (as *AppState) Settings() *uiscreen.SettingsStore {
+	return as.settings
+}
+
+// Profile returns the signed-in user's profile store
+func (as *AppState) Profile() *uiscreen.ProfileStore {
+	return as.profile
 }
 
 func main() {
 	// Create the app
-	a := app.New()
+	a := app.NewWithID("com.skilldar.client") // Unique ID required for preferences storage
+
+	// A launch for a link while the app runs hands the link over and exits
+	if forwardLaunchLinks(a) {
+		return
+	}
+
 	w := a.NewWindow("SkillDar")
 	w.SetMaster()
 	w.Resize(fyne.NewSize(390, 844)) // iPhone 12/13 size
 
 	// Initialize app state
 	state := &AppState{
-		app:               a,
-		window:            w,
-		isDarkTheme:       false, // Start with LIGHT theme
-		screens:           make(map[string]fyne.CanvasObject),
-		icons:             initializeIcons(),
-		userRole:          "client", // Default to client role
-		connectionManager: uiscreen.NewConnectionManager(a),
+		app:       a,
+		window:    w,
+		userRole:  "client", // Default to client role
+		router:    router.New(),
+		workers:   map[string]uiscreen.WorkerProfile{},
+		favorites: uiscreen.NewFavoritesStore(a, uiscreen.DefaultAPIConfig()),
+		profile:   uiscreen.NewProfileStore(uiscreen.DefaultAPIConfig()),
+		addresses: uiscreen.NewAddressStore(a, uiscreen.DefaultAPIConfig()),
+		settings:  uiscreen.NewSettingsStore(a),
 	}
+	state.notifications = uiscreen.NewNotificationManager(state.settings)
 
-	// Set initial theme
-	a.Settings().SetTheme(skilltheme.NewSkillKonnectTheme(theme.VariantLight))
+	// Restore the saved language and theme, and apply setting changes as they are made
+	state.applyLanguage()
+	state.applyTheme()
+	state.settings.OnChanged(state.applyLanguage)
+	state.settings.OnChanged(state.applyTheme)
 
 	// Register screens
-	state.screens["welcome"] = uiscreen.CreateWelcomeScreen(state)
-	state.screens["login"] = uiscreen.CreateLoginScreen(state)
-	state.screens["main"] = uiscreen.CreateMainScreen(state)
-	state.screens["profile"] = uiscreen.CreateProfileScreen(state)
-	state.screens["edit_profile_client"] = uiscreen.CreateEditProfileClientScreen(state)
+	state.registerScreens()
+
+	// Refresh saved workers in the background, the cached list is shown meanwhile
+	state.favorites.Sync(nil)
+
+	// Every navigation shows the screen on top of the back stack
+	state.router.OnChange(state.showRoute)
+	w.Canvas().SetOnTypedKey(state.handleKey)
 
 	// Show welcome screen first
-	state.ShowScreen("welcome")
+	state.Navigate(router.To(router.Welcome))
+
+	// Links the app was launched with, and links opened while it runs
+	receiveLinks(a, w, state.OpenLink)
 
 	// Make sure window is visible
 	w.Show()
@@ -0,0 +1,152 @@
+package assets
+
+import (
+	"bytes"
+	"os"
+	"path"
+	"testing"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/theme"
+
+	"skillDar/pkg/assets/internal/assetgen"
+)
+
+// TestRegistryUpToDate fails when a file was added, renamed or removed
+// without running go generate
+func TestRegistryUpToDate(t *testing.T) {
+	want, err := assetgen.Generate("files")
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := os.ReadFile("registry_gen.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Error("registry_gen.go is out of date, run go generate ./pkg/assets")
+	}
+}
+
+// TestFilesExist checks every registered file is on disk, non-empty and bundled
+func TestFilesExist(t *testing.T) {
+	for key, files := range registry {
+		if len(files) == 0 {
+			t.Errorf("%s has no files", key)
+		}
+		for _, f := range files {
+			if f.res == nil || len(f.res.Content()) == 0 {
+				t.Errorf("%s: empty resource", key)
+				continue
+			}
+			info, err := os.Stat(f.res.Name())
+			if err != nil {
+				t.Errorf("%s: %v", key, err)
+				continue
+			}
+			if info.Size() != int64(len(f.res.Content())) {
+				t.Errorf("%s: bundled %s differs from the file, run go generate ./pkg/assets", key, f.res.Name())
+			}
+		}
+	}
+}
+
+// TestKeysResolve checks every key gives a resource for both themes at every scale
+func TestKeysResolve(t *testing.T) {
+	for _, key := range Keys() {
+		for _, variant := range []fyne.ThemeVariant{theme.VariantLight, theme.VariantDark} {
+			for _, scale := range []float32{0.75, 1, 1.5, 2, 3, 4} {
+				if res := Resource(key, variant, scale); res == nil {
+					t.Errorf("Resource(%s, %d, %v) = nil", key, variant, scale)
+				}
+			}
+		}
+	}
+	if res := Resource("acfixing", theme.VariantLight, 1); res != nil {
+		t.Errorf("unknown key gave %s", res.Name())
+	}
+}
+
+func TestResourceSelection(t *testing.T) {
+	tests := []struct {
+		key     Key
+		variant fyne.ThemeVariant
+		scale   float32
+		want    string
+	}{
+		{Plumbing, theme.VariantLight, 1, "plumbing@1x.png"},
+		{Plumbing, theme.VariantDark, 1, "plumbing@1x.png"},
+		{Plumbing, theme.VariantLight, 1.5, "plumbing@2x.png"},
+		{Plumbing, theme.VariantLight, 2, "plumbing@2x.png"},
+		{Plumbing, theme.VariantLight, 0.5, "plumbing@1x.png"},
+		{Plumbing, theme.VariantLight, 4, "plumbing@3x.png"},
+		{ThemeToggle, theme.VariantLight, 1, "theme_toggle.light.png"},
+		{ThemeToggle, theme.VariantDark, 2, "theme_toggle.dark.png"},
+		{Logo, theme.VariantDark, 3, "logo.png"},
+		{Verified, theme.VariantDark, 1, "verified.svg"},
+	}
+	for _, tt := range tests {
+		res := Resource(tt.key, tt.variant, tt.scale)
+		if res == nil {
+			t.Errorf("Resource(%s) = nil", tt.key)
+			continue
+		}
+		if got := path.Base(res.Name()); got != tt.want {
+			t.Errorf("Resource(%s, %d, %v) = %s, want %s", tt.key, tt.variant, tt.scale, got, tt.want)
+		}
+	}
+}
+
+func TestSVGIconsAreThemed(t *testing.T) {
+	for _, key := range Keys() {
+		res := Resource(key, theme.VariantLight, 1)
+		_, themed := res.(*theme.ThemedResource)
+		if themed != IsTinted(res) {
+			t.Errorf("%s: themed = %v, SVG = %v", key, themed, IsTinted(res))
+		}
+	}
+	if !HasVariants(ThemeToggle) || HasVariants(Plumbing) {
+		t.Error("HasVariants does not match the files")
+	}
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name    string
+		want    assetgen.File
+		wantErr bool
+	}{
+		{"logo.png", assetgen.File{Name: "logo.png", Key: "logo", Scale: 1}, false},
+		{"plumbing@2x.png", assetgen.File{Name: "plumbing@2x.png", Key: "plumbing", Scale: 2}, false},
+		{"theme_toggle.dark.png", assetgen.File{Name: "theme_toggle.dark.png", Key: "theme_toggle", Variant: "dark", Scale: 1}, false},
+		{"badge.light@3x.png", assetgen.File{Name: "badge.light@3x.png", Key: "badge", Variant: "light", Scale: 3}, false},
+		{"verified.svg", assetgen.File{Name: "verified.svg", Key: "verified", Scale: 1}, false},
+		{"AC_repaire.png", assetgen.File{}, true},
+		{"icon@2x.svg", assetgen.File{}, true},
+		{"logo.sepia.png", assetgen.File{}, true},
+		{"notes.txt", assetgen.File{}, true},
+	}
+	for _, tt := range tests {
+		got, err := assetgen.Parse(tt.name)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("Parse(%q) error = %v, wantErr %v", tt.name, err, tt.wantErr)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("Parse(%q) = %+v, want %+v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestResourceName(t *testing.T) {
+	tests := []struct{ file, want string }{
+		{"logo.png", "resourceLogoPng"},
+		{"air_conditioning@2x.png", "resourceAirconditioning2xPng"},
+		{"theme_toggle.dark.png", "resourceThemetoggleDarkPng"},
+	}
+	for _, tt := range tests {
+		if got := assetgen.ResourceName(tt.file); got != tt.want {
+			t.Errorf("ResourceName(%q) = %q, want %q", tt.file, got, tt.want)
+		}
+	}
+}
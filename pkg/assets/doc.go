@@ -0,0 +1,11 @@
+// Package assets is the typed registry of the images and icons bundled with the app.
+//
+// Files live in the files directory and are named
+// <key>[.<variant>][@<scale>x].<ext>, e.g. "theme_toggle.dark.png" or
+// "plumbing@2x.png". The variant is light or dark, the scale picks the
+// image for the screen density. SVG icons are tinted with the theme's
+// foreground color. After adding or renaming a file, run go generate.
+package assets
+
+//go:generate fyne bundle -o bundle.go --package assets files
+//go:generate go run ./internal/assetgen/cmd -dir files -o registry_gen.go
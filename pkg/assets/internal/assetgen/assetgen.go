@@ -0,0 +1,134 @@
+// Package assetgen generates the typed asset registry from the bundled files
+package assetgen
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// File is a bundled file, described by its name
+type File struct {
+	Name    string // File name, e.g. "plumbing@2x.png"
+	Key     string // Asset key, e.g. "plumbing"
+	Variant string // "light", "dark" or empty for both
+	Scale   int    // Screen scale, 1 if the name has none
+}
+
+var fileRegex = regexp.MustCompile(`^([a-z][a-z0-9_]*)(?:\.(light|dark))?(?:@([1-9])x)?\.(png|jpg|svg)$`)
+
+// Parse reads the key, variant and scale from a file name
+func Parse(name string) (File, error) {
+	m := fileRegex.FindStringSubmatch(name)
+	if m == nil {
+		return File{}, fmt.Errorf("assetgen: %q is not named <key>[.light|.dark][@<n>x].png|jpg|svg", name)
+	}
+	f := File{Name: name, Key: m[1], Variant: m[2], Scale: 1}
+	if m[3] != "" {
+		f.Scale, _ = strconv.Atoi(m[3])
+	}
+	if m[4] == "svg" && f.Scale != 1 {
+		return File{}, fmt.Errorf("assetgen: %q: SVG icons have no scale", name)
+	}
+	return f, nil
+}
+
+// ConstName returns the Go constant for a key, e.g. "air_conditioning" gives "AirConditioning"
+func ConstName(key string) string {
+	var b strings.Builder
+	for _, part := range strings.Split(key, "_") {
+		if part == "" {
+			continue
+		}
+		b.WriteString(strings.ToUpper(part[:1]))
+		b.WriteString(part[1:])
+	}
+	return b.String()
+}
+
+var nonAlnum = regexp.MustCompile(`[^a-zA-Z0-9]`)
+
+// ResourceName returns the variable fyne bundle declares for a file
+func ResourceName(name string) string {
+	titled := []rune(name)
+	prevSep := true
+	for i, r := range titled {
+		if prevSep {
+			titled[i] = unicode.ToUpper(r)
+		}
+		prevSep = !(unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_')
+	}
+	return "resource" + nonAlnum.ReplaceAllString(string(titled), "")
+}
+
+// Scan returns the files of an asset directory, sorted by key
+func Scan(dir string) ([]File, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	var files []File
+	for _, e := range entries {
+		if e.IsDir() || strings.HasPrefix(e.Name(), ".") {
+			continue
+		}
+		f, err := Parse(e.Name())
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, f)
+	}
+	sort.Slice(files, func(i, j int) bool {
+		if files[i].Key != files[j].Key {
+			return files[i].Key < files[j].Key
+		}
+		if files[i].Variant != files[j].Variant {
+			return files[i].Variant < files[j].Variant
+		}
+		return files[i].Scale < files[j].Scale
+	})
+	return files, nil
+}
+
+// Generate returns the registry source for the files of an asset directory
+func Generate(dir string) ([]byte, error) {
+	files, err := Scan(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var keys []string
+	byKey := map[string][]File{}
+	for _, f := range files {
+		if _, ok := byKey[f.Key]; !ok {
+			keys = append(keys, f.Key)
+		}
+		byKey[f.Key] = append(byKey[f.Key], f)
+	}
+
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "// Code generated by assetgen from the %s directory. DO NOT EDIT.\n\n", dir)
+	b.WriteString("package assets\n\n")
+	b.WriteString("// Bundled assets\nconst (\n")
+	for _, key := range keys {
+		fmt.Fprintf(&b, "\t%s Key = %q\n", ConstName(key), key)
+	}
+	b.WriteString(")\n\n")
+	b.WriteString("var registry = map[Key][]file{\n")
+	for _, key := range keys {
+		fmt.Fprintf(&b, "\t%s: {\n", ConstName(key))
+		for _, f := range byKey[key] {
+			fmt.Fprintf(&b, "\t\t{variant: %q, scale: %d, res: %s},\n", f.Variant, f.Scale, ResourceName(f.Name))
+		}
+		b.WriteString("\t},\n")
+	}
+	b.WriteString("}\n")
+
+	return format.Source(b.Bytes())
+}
@@ -0,0 +1,24 @@
+// Command assetgen writes the typed asset registry, run by go generate
+package main
+
+import (
+	"flag"
+	"log"
+	"os"
+
+	"skillDar/pkg/assets/internal/assetgen"
+)
+
+func main() {
+	dir := flag.String("dir", "files", "directory of the bundled files")
+	out := flag.String("o", "registry_gen.go", "output file")
+	flag.Parse()
+
+	src, err := assetgen.Generate(*dir)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(*out, src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
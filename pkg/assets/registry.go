@@ -0,0 +1,92 @@
+package assets
+
+import (
+	"sort"
+	"strings"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/theme"
+)
+
+// Key names a bundled asset, the constants are generated from the file names
+type Key string
+
+// file is one bundled file of an asset
+type file struct {
+	variant string // "light", "dark" or empty for both
+	scale   int    // Screen scale the image is made for, 1 for SVG
+	res     fyne.Resource
+}
+
+// Resource returns the asset for a theme variant and screen scale.
+// It picks the file for the variant, falling back to the one for both,
+// and the smallest image at least as dense as the screen. SVG icons are
+// returned as themed resources. It returns nil for unknown keys.
+func Resource(key Key, variant fyne.ThemeVariant, scale float32) fyne.Resource {
+	files := registry[key]
+	want := "light"
+	if variant == theme.VariantDark {
+		want = "dark"
+	}
+
+	var best *file
+	for _, matchVariant := range []string{want, ""} {
+		for i := range files {
+			f := &files[i]
+			if f.variant != matchVariant {
+				continue
+			}
+			if best == nil || betterScale(f.scale, best.scale, scale) {
+				best = f
+			}
+		}
+		if best != nil {
+			break
+		}
+	}
+	if best == nil {
+		return nil
+	}
+	if IsTinted(best.res) {
+		return theme.NewThemedResource(best.res)
+	}
+	return best.res
+}
+
+// betterScale reports whether an image made for scale a suits the screen
+// better than one made for scale b
+func betterScale(a, b int, screen float32) bool {
+	aFits, bFits := float32(a) >= screen, float32(b) >= screen
+	switch {
+	case aFits && bFits:
+		return a < b
+	case aFits != bFits:
+		return aFits
+	}
+	return a > b
+}
+
+// IsTinted reports whether a resource is an SVG icon tinted with the theme
+func IsTinted(res fyne.Resource) bool {
+	return strings.HasSuffix(res.Name(), ".svg")
+}
+
+// Keys returns all registered asset keys, sorted
+func Keys() []Key {
+	keys := make([]Key, 0, len(registry))
+	for k := range registry {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+	return keys
+}
+
+// HasVariants reports whether an asset has separate light and dark files
+func HasVariants(key Key) bool {
+	for _, f := range registry[key] {
+		if f.variant != "" {
+			return true
+		}
+	}
+	return false
+}
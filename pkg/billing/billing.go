@@ -0,0 +1,154 @@
+package billing
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"skillDar/pkg/money"
+)
+
+// Method is the way a client pays for a booking
+type Method string
+
+const (
+	MethodCash   Method = "cash"   // Paid to the worker when the job is completed
+	MethodCard   Method = "card"   // Paid online on the gateway's hosted checkout page
+	MethodWallet Method = "wallet" // Debited from the in-app wallet balance
+)
+
+// Status is the state of a payment
+type Status string
+
+const (
+	StatusPending    Status = "pending"    // Waiting for the client, e.g. on the checkout page
+	StatusAuthorized Status = "authorized" // Guaranteed, collected when the job is completed
+	StatusPaid       Status = "paid"       // Money received
+	StatusFailed     Status = "failed"     // Declined by the gateway or insufficient balance
+	StatusCancelled  Status = "cancelled"  // Abandoned before payment or voided
+	StatusRefunded   Status = "refunded"   // Paid, then returned to the client
+)
+
+// Settled reports whether the status will not change without a new action
+func (s Status) Settled() bool {
+	return s != StatusPending
+}
+
+// Confirmed reports whether the booking can go ahead with this payment
+func (s Status) Confirmed() bool {
+	return s == StatusAuthorized || s == StatusPaid
+}
+
+// Payment is a payment attempt for an order
+type Payment struct {
+	ID          string      `json:"id"`
+	OrderID     string      `json:"order_id"`
+	Method      Method      `json:"method"`
+	Amount      money.Money `json:"amount"`
+	Status      Status      `json:"status"`
+	CheckoutURL string      `json:"checkout_url,omitempty"` // Hosted checkout page, card payments only
+	Reason      string      `json:"reason,omitempty"`       // Why the payment failed
+	CreatedAt   time.Time   `json:"created_at"`
+}
+
+// Receipt is the proof of a settled payment or refund
+type Receipt struct {
+	Number    string      `json:"number"`
+	PaymentID string      `json:"payment_id"`
+	OrderID   string      `json:"order_id"`
+	Method    Method      `json:"method"`
+	Amount    money.Money `json:"amount"`
+	Refunded  money.Money `json:"refunded"`
+	IssuedAt  time.Time   `json:"issued_at"`
+	URL       string      `json:"url,omitempty"` // PDF version
+}
+
+// PaymentProvider takes payments with one payment method
+type PaymentProvider interface {
+	// Method returns the payment method handled by the provider
+	Method() Method
+	// Pay starts paying an order or a wallet top-up, identified by orderID.
+	// Card payments come back pending with a checkout URL the client must visit.
+	Pay(orderID string, amount money.Money) (Payment, error)
+	// Status returns the current state of a payment
+	Status(paymentID string) (Payment, error)
+	// Refund returns an amount of a paid payment to the client,
+	// or voids a payment that was not collected yet
+	Refund(paymentID string, amount money.Money) (Payment, error)
+	// Receipt returns the receipt of a settled payment
+	Receipt(paymentID string) (Receipt, error)
+}
+
+// Backend sends JSON requests to the SkillDar API
+type Backend interface {
+	Do(method, path string, body, out any) error
+}
+
+// ErrUnsupportedMethod is returned when no provider handles a payment method
+var ErrUnsupportedMethod = errors.New("payment method not supported")
+
+// backendProvider talks to the payment endpoints of the API, which relay
+// the gateways' webhooks into the payment status
+type backendProvider struct {
+	backend   Backend
+	method    Method
+	returnURL string
+}
+
+// payRequest starts a payment on the backend
+type payRequest struct {
+	OrderID   string      `json:"order_id"`
+	Method    Method      `json:"method"`
+	Amount    money.Money `json:"amount"`
+	ReturnURL string      `json:"return_url,omitempty"`
+}
+
+func (p *backendProvider) Method() Method {
+	return p.method
+}
+
+func (p *backendProvider) Pay(orderID string, amount money.Money) (Payment, error) {
+	var payment Payment
+	req := payRequest{OrderID: orderID, Method: p.method, Amount: amount, ReturnURL: p.returnURL}
+	err := p.backend.Do(http.MethodPost, "/payments", req, &payment)
+	return payment, err
+}
+
+func (p *backendProvider) Status(paymentID string) (Payment, error) {
+	var payment Payment
+	err := p.backend.Do(http.MethodGet, "/payments/"+url.PathEscape(paymentID), nil, &payment)
+	return payment, err
+}
+
+func (p *backendProvider) Refund(paymentID string, amount money.Money) (Payment, error) {
+	var payment Payment
+	path := fmt.Sprintf("/payments/%s/refund", url.PathEscape(paymentID))
+	err := p.backend.Do(http.MethodPost, path, map[string]money.Money{"amount": amount}, &payment)
+	return payment, err
+}
+
+func (p *backendProvider) Receipt(paymentID string) (Receipt, error) {
+	var receipt Receipt
+	path := fmt.Sprintf("/payments/%s/receipt", url.PathEscape(paymentID))
+	err := p.backend.Do(http.MethodGet, path, nil, &receipt)
+	return receipt, err
+}
+
+// NewCashProvider creates a provider for cash paid to the worker on completion.
+// The payment is authorized right away and marked paid when the worker completes the job.
+func NewCashProvider(backend Backend) PaymentProvider {
+	return &backendProvider{backend: backend, method: MethodCash}
+}
+
+// NewCardProvider creates a provider for card payments on the gateway's hosted
+// checkout page. The gateway redirects to returnURL once the client is done.
+func NewCardProvider(backend Backend, returnURL string) PaymentProvider {
+	return &backendProvider{backend: backend, method: MethodCard, returnURL: returnURL}
+}
+
+// NewWalletProvider creates a provider debiting the client's wallet balance
+func NewWalletProvider(backend Backend) PaymentProvider {
+	return &backendProvider{backend: backend, method: MethodWallet}
+}
@@ -0,0 +1,231 @@
+package billing
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"skillDar/pkg/money"
+)
+
+func TestPayAndConfirm(t *testing.T) {
+	tests := []struct {
+		name         string
+		method       Method
+		pendingPolls int
+		outcome      Status
+		wantStatus   Status
+		wantOpened   bool
+		wantErr      bool
+	}{
+		{"cash is authorized until completion", MethodCash, 0, "", StatusAuthorized, false, false},
+		{"wallet is paid immediately", MethodWallet, 0, "", StatusPaid, false, false},
+		{"wallet with insufficient balance", MethodWallet, 0, StatusFailed, StatusFailed, false, true},
+		{"card paid on checkout page", MethodCard, 3, "", StatusPaid, true, false},
+		{"card declined", MethodCard, 2, StatusFailed, StatusFailed, true, true},
+		{"card checkout abandoned", MethodCard, 1, StatusCancelled, StatusCancelled, true, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			provider := NewFakeProvider(tt.method)
+			provider.PendingPolls = tt.pendingPolls
+			provider.Outcome = tt.outcome
+			provider.Reason = "declined"
+
+			var opened string
+			var seen []Status
+			checkout := Checkout{
+				Provider:     provider,
+				OpenURL:      func(u string) error { opened = u; return nil },
+				OnStatus:     func(p Payment) { seen = append(seen, p.Status) },
+				PollInterval: time.Millisecond,
+			}
+
+			payment, err := checkout.PayAndConfirm(context.Background(), "order-1", money.Dinars(120))
+			if payment.Status != tt.wantStatus {
+				t.Errorf("status = %q, want %q", payment.Status, tt.wantStatus)
+			}
+			if (opened != "") != tt.wantOpened {
+				t.Errorf("checkout page opened = %q, want opened %v", opened, tt.wantOpened)
+			}
+			var paymentErr *PaymentError
+			if tt.wantErr != errors.As(err, &paymentErr) {
+				t.Errorf("err = %v, want payment error %v", err, tt.wantErr)
+			}
+			if tt.method == MethodCard && len(seen) != tt.pendingPolls+1 {
+				t.Errorf("saw %d status updates, want %d", len(seen), tt.pendingPolls+1)
+			}
+		})
+	}
+}
+
+func TestCheckoutTimeout(t *testing.T) {
+	provider := NewFakeProvider(MethodCard)
+	provider.PendingPolls = 1000
+
+	checkout := Checkout{Provider: provider, PollInterval: time.Millisecond, Timeout: 10 * time.Millisecond}
+	payment, err := checkout.PayAndConfirm(context.Background(), "order-1", money.Dinars(50))
+	if !errors.Is(err, ErrTimeout) {
+		t.Fatalf("err = %v, want ErrTimeout", err)
+	}
+	if payment.Status != StatusPending {
+		t.Errorf("status = %q, want pending", payment.Status)
+	}
+}
+
+func TestCheckoutCancelled(t *testing.T) {
+	provider := NewFakeProvider(MethodCard)
+	provider.PendingPolls = 1000
+
+	ctx, cancel := context.WithCancel(context.Background())
+	checkout := Checkout{
+		Provider:     provider,
+		OnStatus:     func(Payment) { cancel() },
+		PollInterval: time.Hour,
+	}
+	payment, err := checkout.PayAndConfirm(ctx, "order-1", money.Dinars(50))
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("err = %v, want context.Canceled", err)
+	}
+	if payment.Status != StatusPending {
+		t.Errorf("status = %q, want pending", payment.Status)
+	}
+}
+
+func TestRefundOnCancel(t *testing.T) {
+	tests := []struct {
+		name       string
+		method     Method
+		complete   bool
+		wantStatus Status
+	}{
+		{"uncollected cash is voided", MethodCash, false, StatusCancelled},
+		{"collected cash is refunded", MethodCash, true, StatusRefunded},
+		{"card is refunded", MethodCard, false, StatusRefunded},
+		{"wallet is refunded", MethodWallet, false, StatusRefunded},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			provider := NewFakeProvider(tt.method)
+			payment, err := Checkout{Provider: provider, PollInterval: time.Millisecond}.PayAndConfirm(context.Background(), "order-1", money.Millimes(80500))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if tt.complete {
+				if err := provider.Complete(payment.ID); err != nil {
+					t.Fatal(err)
+				}
+				payment.Status = StatusPaid
+			}
+
+			refunded, err := RefundOnCancel(provider, payment)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if refunded.Status != tt.wantStatus {
+				t.Errorf("status = %q, want %q", refunded.Status, tt.wantStatus)
+			}
+		})
+	}
+}
+
+func TestRefundOnCancelFailedPayment(t *testing.T) {
+	provider := NewFakeProvider(MethodWallet)
+	provider.Outcome = StatusFailed
+	payment, _ := Checkout{Provider: provider}.PayAndConfirm(context.Background(), "order-1", money.Dinars(10))
+
+	got, err := RefundOnCancel(provider, payment)
+	if err != nil || got.Status != StatusFailed {
+		t.Errorf("RefundOnCancel = %q, %v, want failed payment left alone", got.Status, err)
+	}
+}
+
+func TestReceipt(t *testing.T) {
+	provider := NewFakeProvider(MethodCard)
+	payment, err := Checkout{Provider: provider}.PayAndConfirm(context.Background(), "order-7", money.Dinars(200))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := provider.Refund(payment.ID, money.Dinars(50)); err != nil {
+		t.Fatal(err)
+	}
+	receipt, err := provider.Receipt(payment.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if receipt.OrderID != "order-7" || receipt.Amount != money.Dinars(200) || receipt.Refunded != money.Dinars(50) {
+		t.Errorf("receipt = %+v", receipt)
+	}
+
+	if _, err := provider.Refund(payment.ID, money.Dinars(151)); err == nil {
+		t.Error("refunding more than paid should fail")
+	}
+
+	cash := NewFakeProvider(MethodCash)
+	authorized, _ := cash.Pay("order-8", money.Dinars(10))
+	if _, err := cash.Receipt(authorized.ID); err == nil {
+		t.Error("receipt before the cash is collected should fail")
+	}
+	if _, err := provider.Receipt("missing"); !errors.Is(err, ErrPaymentNotFound) {
+		t.Errorf("receipt for an unknown payment: err = %v", err)
+	}
+}
+
+func TestFakeWalletBalance(t *testing.T) {
+	provider := NewFakeProvider(MethodWallet)
+	provider.SetBalance(money.Dinars(100))
+	checkout := Checkout{Provider: provider}
+
+	first, err := checkout.PayAndConfirm(context.Background(), "order-1", money.Dinars(60))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := provider.Balance(); got != money.Dinars(40) {
+		t.Errorf("balance after payment = %s, want 40", got)
+	}
+
+	second, err := checkout.PayAndConfirm(context.Background(), "order-2", money.Dinars(50))
+	if second.Status != StatusFailed || second.Reason != ReasonInsufficientBalance {
+		t.Errorf("payment over balance = %q (%s), want failed for insufficient balance", second.Status, second.Reason)
+	}
+	if err == nil {
+		t.Error("payment over balance should return an error")
+	}
+
+	if _, err := RefundOnCancel(provider, first); err != nil {
+		t.Fatal(err)
+	}
+	if got := provider.Balance(); got != money.Dinars(100) {
+		t.Errorf("balance after refund = %s, want 100", got)
+	}
+}
+
+func TestCheckBalance(t *testing.T) {
+	tests := []struct {
+		balance, amount money.Money
+		wantMissing     money.Money
+	}{
+		{money.Dinars(100), money.Dinars(100), money.Money{}},
+		{money.Dinars(100), money.Millimes(99500), money.Money{}},
+		{money.Millimes(12500), money.Dinars(40), money.Millimes(27500)},
+		{money.Money{}, money.Dinars(1), money.Dinars(1)},
+	}
+
+	for _, tt := range tests {
+		err := CheckBalance(tt.balance, tt.amount)
+		var short *InsufficientBalanceError
+		if !errors.As(err, &short) {
+			if !tt.wantMissing.IsZero() {
+				t.Errorf("CheckBalance(%s, %s) = nil, want %s missing", tt.balance, tt.amount, tt.wantMissing)
+			}
+			continue
+		}
+		if got := short.Missing(); got.Cmp(tt.wantMissing) != 0 {
+			t.Errorf("CheckBalance(%s, %s) missing %s, want %s", tt.balance, tt.amount, got, tt.wantMissing)
+		}
+	}
+}
@@ -0,0 +1,137 @@
+package billing
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"skillDar/pkg/money"
+)
+
+// Default polling settings while the client is on the checkout page
+const (
+	DefaultPollInterval = 2 * time.Second
+	DefaultTimeout      = 10 * time.Minute
+)
+
+// ErrTimeout is returned when a payment is still pending after the checkout timeout
+var ErrTimeout = errors.New("payment was not completed in time")
+
+// PaymentError is returned when a payment ends without being confirmed
+type PaymentError struct {
+	Payment Payment
+}
+
+func (e *PaymentError) Error() string {
+	if e.Payment.Reason != "" {
+		return "payment " + string(e.Payment.Status) + ": " + e.Payment.Reason
+	}
+	return "payment " + string(e.Payment.Status)
+}
+
+// Providers maps each payment method to the provider handling it
+type Providers map[Method]PaymentProvider
+
+// NewProviders registers the given providers by their method
+func NewProviders(providers ...PaymentProvider) Providers {
+	m := make(Providers, len(providers))
+	for _, p := range providers {
+		m[p.Method()] = p
+	}
+	return m
+}
+
+// Get returns the provider for a payment method
+func (p Providers) Get(method Method) (PaymentProvider, error) {
+	provider, ok := p[method]
+	if !ok {
+		return nil, ErrUnsupportedMethod
+	}
+	return provider, nil
+}
+
+// Checkout pays an order and waits until the payment is confirmed or fails
+type Checkout struct {
+	Provider PaymentProvider
+
+	// OpenURL opens the hosted checkout page, e.g. in the system browser
+	OpenURL func(string) error
+	// OnStatus is called with each payment state seen while waiting, may be nil
+	OnStatus func(Payment)
+
+	PollInterval time.Duration // DefaultPollInterval if zero
+	Timeout      time.Duration // DefaultTimeout if zero
+}
+
+// PayAndConfirm starts the payment, sends the client to the checkout page when
+// needed and polls until the backend reports the result.
+// It returns a *PaymentError when the payment failed or was cancelled, and
+// the context's error when ctx is done first, e.g. the screen was closed.
+func (c Checkout) PayAndConfirm(ctx context.Context, orderID string, amount money.Money) (Payment, error) {
+	payment, err := c.Provider.Pay(orderID, amount)
+	if err != nil {
+		return payment, err
+	}
+	c.report(payment)
+
+	if payment.Status == StatusPending && payment.CheckoutURL != "" && c.OpenURL != nil {
+		if err := c.OpenURL(payment.CheckoutURL); err != nil {
+			return payment, err
+		}
+	}
+	return c.Wait(ctx, payment)
+}
+
+// Wait polls a pending payment until it is settled or ctx is done
+func (c Checkout) Wait(ctx context.Context, payment Payment) (Payment, error) {
+	interval := c.PollInterval
+	if interval <= 0 {
+		interval = DefaultPollInterval
+	}
+	timeout := c.Timeout
+	if timeout <= 0 {
+		timeout = DefaultTimeout
+	}
+	deadline := time.Now().Add(timeout)
+
+	for !payment.Status.Settled() {
+		if time.Now().After(deadline) {
+			return payment, ErrTimeout
+		}
+		select {
+		case <-ctx.Done():
+			return payment, ctx.Err()
+		case <-time.After(interval):
+		}
+
+		next, err := c.Provider.Status(payment.ID)
+		if err != nil {
+			// Keep polling through transient network errors until the deadline
+			continue
+		}
+		payment = next
+		c.report(payment)
+	}
+
+	if !payment.Status.Confirmed() {
+		return payment, &PaymentError{Payment: payment}
+	}
+	return payment, nil
+}
+
+func (c Checkout) report(payment Payment) {
+	if c.OnStatus != nil {
+		c.OnStatus(payment)
+	}
+}
+
+// RefundOnCancel returns the money of a cancelled order to the client.
+// Paid payments are refunded in full, authorized and pending ones are voided,
+// payments that never went through are left as they are.
+func RefundOnCancel(provider PaymentProvider, payment Payment) (Payment, error) {
+	switch payment.Status {
+	case StatusPaid, StatusAuthorized, StatusPending:
+		return provider.Refund(payment.ID, payment.Amount)
+	}
+	return payment, nil
+}
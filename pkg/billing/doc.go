@@ -0,0 +1,6 @@
+// Package billing takes payments for bookings through pluggable providers:
+// cash on completion, card through a hosted checkout page, and the in-app wallet.
+// Gateways report payment results to the backend, which the app polls for status.
+// FakeProvider settles payments in memory so the pay-and-confirm flow can be
+// exercised without a real gateway.
+package billing
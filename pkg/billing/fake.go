@@ -0,0 +1,206 @@
+package billing
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"skillDar/pkg/money"
+)
+
+// ErrPaymentNotFound is returned for an unknown payment ID
+var ErrPaymentNotFound = errors.New("payment not found")
+
+// FakeProvider settles payments in memory, as a stand-in for a real gateway
+// in tests and demos. Card payments stay pending for PendingPolls status checks,
+// like a client filling in the checkout page.
+type FakeProvider struct {
+	// PendingPolls is the number of status checks a card payment stays pending
+	PendingPolls int
+	// Outcome is the status card and wallet payments settle to, StatusPaid if empty
+	Outcome Status
+	// Reason is reported with a failed outcome
+	Reason string
+
+	mu       sync.Mutex
+	method   Method
+	next     int
+	payments map[string]*fakePayment
+
+	limited bool        // Wallet payments are checked against balance
+	balance money.Money // Remaining wallet balance
+}
+
+type fakePayment struct {
+	payment  Payment
+	polls    int
+	refunded money.Money
+}
+
+// NewFakeProvider creates a fake provider for a payment method
+func NewFakeProvider(method Method) *FakeProvider {
+	return &FakeProvider{method: method, payments: make(map[string]*fakePayment)}
+}
+
+// SetBalance limits wallet payments to a balance, they are unlimited otherwise
+func (f *FakeProvider) SetBalance(balance money.Money) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.limited = true
+	f.balance = balance
+}
+
+// Balance returns the remaining wallet balance set with SetBalance
+func (f *FakeProvider) Balance() money.Money {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.balance
+}
+
+func (f *FakeProvider) Method() Method {
+	return f.method
+}
+
+func (f *FakeProvider) outcome() Status {
+	if f.Outcome == "" {
+		return StatusPaid
+	}
+	return f.Outcome
+}
+
+// settle moves a payment to the configured outcome
+func (f *FakeProvider) settle(p *fakePayment) {
+	p.payment.Status = f.outcome()
+	if p.payment.Status == StatusFailed {
+		p.payment.Reason = f.Reason
+	}
+}
+
+func (f *FakeProvider) Pay(orderID string, amount money.Money) (Payment, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.next++
+	p := &fakePayment{
+		payment: Payment{
+			ID:        fmt.Sprintf("fake-%d", f.next),
+			OrderID:   orderID,
+			Method:    f.method,
+			Amount:    amount,
+			Status:    StatusPending,
+			CreatedAt: time.Now(),
+		},
+		polls: f.PendingPolls,
+	}
+
+	switch f.method {
+	case MethodCash:
+		p.payment.Status = StatusAuthorized
+	case MethodCard:
+		p.payment.CheckoutURL = "https://checkout.example.com/" + p.payment.ID
+		if p.polls <= 0 {
+			f.settle(p)
+		}
+	case MethodWallet:
+		if f.limited && CheckBalance(f.balance, amount) != nil {
+			p.payment.Status = StatusFailed
+			p.payment.Reason = ReasonInsufficientBalance
+			break
+		}
+		f.settle(p)
+		if f.limited && p.payment.Status == StatusPaid {
+			f.balance = f.balance.Sub(amount)
+		}
+	default:
+		f.settle(p)
+	}
+
+	f.payments[p.payment.ID] = p
+	return p.payment, nil
+}
+
+func (f *FakeProvider) Status(paymentID string) (Payment, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	p, ok := f.payments[paymentID]
+	if !ok {
+		return Payment{}, ErrPaymentNotFound
+	}
+	if p.payment.Status == StatusPending {
+		p.polls--
+		if p.polls <= 0 {
+			f.settle(p)
+		}
+	}
+	return p.payment, nil
+}
+
+// Complete marks an authorized cash payment as paid, as when the worker completes the job
+func (f *FakeProvider) Complete(paymentID string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	p, ok := f.payments[paymentID]
+	if !ok {
+		return ErrPaymentNotFound
+	}
+	if p.payment.Status != StatusAuthorized {
+		return fmt.Errorf("cannot complete a %s payment", p.payment.Status)
+	}
+	p.payment.Status = StatusPaid
+	return nil
+}
+
+func (f *FakeProvider) Refund(paymentID string, amount money.Money) (Payment, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	p, ok := f.payments[paymentID]
+	if !ok {
+		return Payment{}, ErrPaymentNotFound
+	}
+
+	switch p.payment.Status {
+	case StatusPending, StatusAuthorized:
+		p.payment.Status = StatusCancelled
+	case StatusPaid:
+		refunded := p.refunded.Add(amount)
+		if amount.Amount <= 0 || refunded.Cmp(p.payment.Amount) > 0 {
+			return p.payment, fmt.Errorf("cannot refund %s of %s", amount, p.payment.Amount)
+		}
+		p.refunded = refunded
+		if f.limited && f.method == MethodWallet {
+			f.balance = f.balance.Add(amount)
+		}
+		if refunded.Cmp(p.payment.Amount) == 0 {
+			p.payment.Status = StatusRefunded
+		}
+	default:
+		return p.payment, fmt.Errorf("cannot refund a %s payment", p.payment.Status)
+	}
+	return p.payment, nil
+}
+
+func (f *FakeProvider) Receipt(paymentID string) (Receipt, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	p, ok := f.payments[paymentID]
+	if !ok {
+		return Receipt{}, ErrPaymentNotFound
+	}
+	if p.payment.Status != StatusPaid && p.payment.Status != StatusRefunded {
+		return Receipt{}, fmt.Errorf("no receipt for a %s payment", p.payment.Status)
+	}
+	return Receipt{
+		Number:    "R-" + p.payment.ID,
+		PaymentID: p.payment.ID,
+		OrderID:   p.payment.OrderID,
+		Method:    p.payment.Method,
+		Amount:    p.payment.Amount,
+		Refunded:  p.refunded,
+		IssuedAt:  time.Now(),
+	}, nil
+}
@@ -0,0 +1,62 @@
+package billing
+
+import (
+	"fmt"
+	"time"
+
+	"skillDar/pkg/money"
+)
+
+// ReasonInsufficientBalance is the failure reason of a wallet payment larger than the balance
+const ReasonInsufficientBalance = "insufficient_balance"
+
+// TransactionKind is the type of a wallet ledger entry
+type TransactionKind string
+
+const (
+	TransactionTopUp       TransactionKind = "topup"        // Credit bought with another payment method
+	TransactionPayment     TransactionKind = "payment"      // Booking paid from the wallet
+	TransactionRefund      TransactionKind = "refund"       // Cancelled booking returned to the wallet
+	TransactionPromoCredit TransactionKind = "promo_credit" // Credit offered by SkillDar
+	TransactionReferral    TransactionKind = "referral"     // Reward for inviting a friend
+)
+
+// Transaction is one entry of the wallet ledger.
+// Amount is positive for credits and negative for debits.
+type Transaction struct {
+	ID          string          `json:"id"`
+	Kind        TransactionKind `json:"kind"`
+	Amount      money.Money     `json:"amount"`
+	Description string          `json:"description"`
+	OrderID     string          `json:"order_id,omitempty"`
+	CreatedAt   time.Time       `json:"created_at"`
+}
+
+// Wallet is the client's prepaid balance and its ledger, most recent first
+type Wallet struct {
+	Balance      money.Money   `json:"balance"`
+	Transactions []Transaction `json:"transactions"`
+}
+
+// InsufficientBalanceError is returned when the wallet cannot cover an amount
+type InsufficientBalanceError struct {
+	Balance money.Money
+	Amount  money.Money
+}
+
+// Missing returns how much must be topped up to cover the amount
+func (e *InsufficientBalanceError) Missing() money.Money {
+	return e.Amount.Sub(e.Balance)
+}
+
+func (e *InsufficientBalanceError) Error() string {
+	return fmt.Sprintf("wallet balance %s is %s short of %s", e.Balance, e.Missing(), e.Amount)
+}
+
+// CheckBalance returns an *InsufficientBalanceError when balance is below amount
+func CheckBalance(balance, amount money.Money) error {
+	if balance.Cmp(amount) < 0 {
+		return &InsufficientBalanceError{Balance: balance, Amount: amount}
+	}
+	return nil
+}
@@ -0,0 +1,13 @@
+// Package i18n translates the app into French and Arabic and formats
+// numbers and dates for the user's language.
+//
+// English is the source language: messages are written in English in the
+// code and looked up in the catalog of the current locale, e.g.
+// T("Settings"). Messages without a translation are shown in English.
+// Catalogs live in the locales directory, one JSON file per language,
+// mapping each English message to its translation. Messages with a count
+// map to their plural forms instead, see N.
+//
+// The current locale is global, like the money locale. Set it with
+// SetLocale from the UI goroutine before building screens.
+package i18n
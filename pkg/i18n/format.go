@@ -0,0 +1,117 @@
+package i18n
+
+import (
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// numberFormat describes how a language writes numbers, the same way the
+// money package writes amounts
+type numberFormat struct {
+	decimal string
+	group   string
+}
+
+var numberFormats = map[string]numberFormat{
+	"en": {decimal: ".", group: ","},
+	"fr": {decimal: ",", group: " "},
+	"ar": {decimal: ",", group: "."},
+}
+
+// FormatNumber writes a whole number for the current locale, e.g.
+// "12,500" (en), "12 500" (fr) or "12.500" (ar)
+func FormatNumber(n int64) string {
+	return FormatDecimal(float64(n), 0)
+}
+
+// FormatDecimal writes a number rounded to decimals places for the
+// current locale, e.g. "4.9" (en) or "4,9" (fr and ar)
+func FormatDecimal(v float64, decimals int) string {
+	f, ok := numberFormats[language(Locale())]
+	if !ok {
+		f = numberFormats["en"]
+	}
+	text := strconv.FormatFloat(math.Abs(v), 'f', decimals, 64)
+	whole, fraction, _ := strings.Cut(text, ".")
+	for i := len(whole) - 3; i > 0; i -= 3 {
+		whole = whole[:i] + f.group + whole[i:]
+	}
+	if fraction != "" {
+		whole += f.decimal + fraction
+	}
+	if v < 0 && strings.Trim(text, "0.") != "" {
+		whole = "-" + whole
+	}
+	return whole
+}
+
+// dateNames are the day and month names of a language. Arabic uses the
+// month names of Tunisia and the Maghreb.
+type dateNames struct {
+	days, shortDays     [7]string  // From Sunday
+	months, shortMonths [12]string // From January
+}
+
+var dateNamesByLanguage = map[string]dateNames{
+	"fr": {
+		days:        [7]string{"dimanche", "lundi", "mardi", "mercredi", "jeudi", "vendredi", "samedi"},
+		shortDays:   [7]string{"dim.", "lun.", "mar.", "mer.", "jeu.", "ven.", "sam."},
+		months:      [12]string{"janvier", "février", "mars", "avril", "mai", "juin", "juillet", "août", "septembre", "octobre", "novembre", "décembre"},
+		shortMonths: [12]string{"janv.", "févr.", "mars", "avr.", "mai", "juin", "juil.", "août", "sept.", "oct.", "nov.", "déc."},
+	},
+	"ar": {
+		days:        [7]string{"الأحد", "الإثنين", "الثلاثاء", "الأربعاء", "الخميس", "الجمعة", "السبت"},
+		shortDays:   [7]string{"الأحد", "الإثنين", "الثلاثاء", "الأربعاء", "الخميس", "الجمعة", "السبت"},
+		months:      [12]string{"جانفي", "فيفري", "مارس", "أفريل", "ماي", "جوان", "جويلية", "أوت", "سبتمبر", "أكتوبر", "نوفمبر", "ديسمبر"},
+		shortMonths: [12]string{"جانفي", "فيفري", "مارس", "أفريل", "ماي", "جوان", "جويلية", "أوت", "سبتمبر", "أكتوبر", "نوفمبر", "ديسمبر"},
+	},
+}
+
+// ShortDayName is the abbreviated name of a weekday, e.g. "Mon"
+func ShortDayName(day time.Weekday) string {
+	if names, ok := dateNamesByLanguage[language(Locale())]; ok {
+		return names.shortDays[day]
+	}
+	return day.String()[:3]
+}
+
+// FormatDate formats a time like time.Format, with the day and month
+// names of the layout ("Monday", "Mon", "January", "Jan") in the current
+// locale, e.g. FormatDate(t, "Mon 02 Jan") is "lun. 02 janv." in French
+func FormatDate(t time.Time, layout string) string {
+	names, ok := dateNamesByLanguage[language(Locale())]
+	if !ok {
+		return t.Format(layout)
+	}
+
+	var b strings.Builder
+	for layout != "" {
+		switch {
+		case strings.HasPrefix(layout, "Monday"):
+			b.WriteString(names.days[t.Weekday()])
+			layout = layout[len("Monday"):]
+		case strings.HasPrefix(layout, "Mon"):
+			b.WriteString(names.shortDays[t.Weekday()])
+			layout = layout[len("Mon"):]
+		case strings.HasPrefix(layout, "January"):
+			b.WriteString(names.months[t.Month()-1])
+			layout = layout[len("January"):]
+		case strings.HasPrefix(layout, "Jan"):
+			b.WriteString(names.shortMonths[t.Month()-1])
+			layout = layout[len("Jan"):]
+		default:
+			// Format everything up to the next name with the standard layout
+			next := len(layout)
+			for _, name := range []string{"Mon", "Jan"} {
+				if i := strings.Index(layout, name); i >= 0 && i < next {
+					next = i
+				}
+			}
+			b.WriteString(t.Format(layout[:next]))
+			layout = layout[next:]
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,138 @@
+package i18n
+
+import (
+	"embed"
+	"encoding/json"
+	"fmt"
+	"path"
+	"strings"
+	"sync/atomic"
+)
+
+// Supported lists the app languages, English first as the fallback
+var Supported = []string{"en", "fr", "ar"}
+
+//go:embed locales/*.json
+var localeFiles embed.FS
+
+// message is a catalog entry, a translation or the plural forms of one
+type message struct {
+	text   string
+	plural map[PluralForm]string
+}
+
+func (m *message) UnmarshalJSON(data []byte) error {
+	if err := json.Unmarshal(data, &m.text); err == nil {
+		return nil
+	}
+	return json.Unmarshal(data, &m.plural)
+}
+
+// catalogs holds the translations by language, loaded once at startup
+var catalogs = mustLoadCatalogs()
+
+func mustLoadCatalogs() map[string]map[string]message {
+	files, err := localeFiles.ReadDir("locales")
+	if err != nil {
+		panic(err)
+	}
+	loaded := make(map[string]map[string]message)
+	for _, f := range files {
+		data, err := localeFiles.ReadFile(path.Join("locales", f.Name()))
+		if err != nil {
+			panic(err)
+		}
+		var catalog map[string]message
+		if err := json.Unmarshal(data, &catalog); err != nil {
+			panic(fmt.Sprintf("i18n: %s: %v", f.Name(), err))
+		}
+		loaded[strings.TrimSuffix(f.Name(), ".json")] = catalog
+	}
+	return loaded
+}
+
+var currentLocale atomic.Value
+
+// SetLocale sets the language messages are translated to, e.g. "fr" or "ar-TN"
+func SetLocale(locale string) {
+	currentLocale.Store(locale)
+}
+
+// Locale returns the current locale, "en" by default
+func Locale() string {
+	if locale, ok := currentLocale.Load().(string); ok {
+		return locale
+	}
+	return "en"
+}
+
+// Detect picks the locale to use: the language chosen in the settings, or
+// the system language when none is chosen, as long as the app speaks it.
+// Anything else falls back to English.
+func Detect(chosen, system string) string {
+	for _, candidate := range []string{chosen, system} {
+		lang := language(candidate)
+		for _, supported := range Supported {
+			if lang == supported {
+				return supported
+			}
+		}
+	}
+	return Supported[0]
+}
+
+// RightToLeft reports whether the current locale is written right to left
+func RightToLeft() bool {
+	return IsRightToLeft(Locale())
+}
+
+// IsRightToLeft reports whether a locale is written right to left
+func IsRightToLeft(locale string) bool {
+	return language(locale) == "ar"
+}
+
+// T translates a message to the current locale. With arguments, the
+// translation is a fmt format they are filled into, e.g.
+// T("Hello %s", name). Translations can reorder them with %[2]s.
+func T(msg string, args ...any) string {
+	text := msg
+	if m, ok := catalogs[language(Locale())][msg]; ok && m.text != "" {
+		text = m.text
+	}
+	if len(args) == 0 {
+		return text
+	}
+	return fmt.Sprintf(text, args...)
+}
+
+// N translates a message with a count, picking the plural form the
+// current locale uses for n. singular is the catalog key and the English
+// text for one, plural the English text for other counts, e.g.
+// N("%d review", "%d reviews", n, n). Forms that spell the count out,
+// like Arabic dual "تقييمان", can leave the verb out.
+func N(singular, plural string, n int, args ...any) string {
+	text := plural
+	if n == 1 {
+		text = singular
+	}
+	lang := language(Locale())
+	if m, ok := catalogs[lang][singular]; ok && m.plural != nil {
+		if form, ok := m.plural[PluralFormOf(lang, n)]; ok {
+			text = form
+		} else if other, ok := m.plural[PluralOther]; ok {
+			text = other
+		}
+	}
+	if len(args) == 0 || !strings.Contains(text, "%") {
+		return text
+	}
+	return fmt.Sprintf(text, args...)
+}
+
+// language returns the language part of a locale such as "fr-TN"
+func language(locale string) string {
+	if i := strings.IndexAny(locale, "-_"); i >= 0 {
+		locale = locale[:i]
+	}
+	return strings.ToLower(locale)
+}
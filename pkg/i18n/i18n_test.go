@@ -0,0 +1,271 @@
+package i18n
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"testing"
+	"time"
+)
+
+// withLocale runs f with the locale set, restoring English afterwards
+func withLocale(t *testing.T, locale string, f func()) {
+	t.Helper()
+	SetLocale(locale)
+	defer SetLocale("en")
+	f()
+}
+
+func TestPluralFormOf(t *testing.T) {
+	tests := []struct {
+		lang string
+		n    int
+		want PluralForm
+	}{
+		{"en", 0, PluralOther},
+		{"en", 1, PluralOne},
+		{"en", 2, PluralOther},
+		{"fr", 0, PluralOne},
+		{"fr", 1, PluralOne},
+		{"fr", 2, PluralOther},
+		{"ar", 0, PluralZero},
+		{"ar", 1, PluralOne},
+		{"ar", 2, PluralTwo},
+		{"ar", 3, PluralFew},
+		{"ar", 10, PluralFew},
+		{"ar", 11, PluralMany},
+		{"ar", 99, PluralMany},
+		{"ar", 100, PluralOther},
+		{"ar", 103, PluralFew},
+		{"ar", 111, PluralMany},
+	}
+	for _, tt := range tests {
+		if got := PluralFormOf(tt.lang, tt.n); got != tt.want {
+			t.Errorf("PluralFormOf(%q, %d) = %q, want %q", tt.lang, tt.n, got, tt.want)
+		}
+	}
+}
+
+func TestDetect(t *testing.T) {
+	tests := []struct {
+		chosen, system string
+		want           string
+	}{
+		{"", "fr-FR", "fr"},
+		{"", "ar-TN", "ar"},
+		{"", "de-DE", "en"},
+		{"", "", "en"},
+		{"en", "ar-TN", "en"},
+		{"ar", "fr-FR", "ar"},
+		{"it", "fr_TN", "fr"},
+	}
+	for _, tt := range tests {
+		if got := Detect(tt.chosen, tt.system); got != tt.want {
+			t.Errorf("Detect(%q, %q) = %q, want %q", tt.chosen, tt.system, got, tt.want)
+		}
+	}
+}
+
+func TestTranslate(t *testing.T) {
+	tests := []struct {
+		locale string
+		got    func() string
+		want   string
+	}{
+		{"en", func() string { return T("Settings") }, "Settings"},
+		{"fr", func() string { return T("Settings") }, "Paramètres"},
+		{"ar-TN", func() string { return T("Settings") }, "الإعدادات"},
+		{"fr", func() string { return T("Not in the catalog") }, "Not in the catalog"},
+		{"fr", func() string { return T("Book %s", "Sami") }, "Réserver Sami"},
+		{"en", func() string { return N("%d review", "%d reviews", 1, 1) }, "1 review"},
+		{"en", func() string { return N("%d review", "%d reviews", 5, 5) }, "5 reviews"},
+		{"fr", func() string { return N("%d free slot", "%d free slots", 0, 0) }, "0 créneau libre"},
+		{"fr", func() string { return N("%d free slot", "%d free slots", 4, 4) }, "4 créneaux libres"},
+		{"ar", func() string { return N("%d review", "%d reviews", 2, 2) }, "تقييمان"},
+		{"ar", func() string { return N("%d review", "%d reviews", 5, 5) }, "5 تقييمات"},
+		{"ar", func() string { return N("%d review", "%d reviews", 100, 100) }, "100 تقييم"},
+		{"fr", func() string { return N("%d unknown", "%d unknowns", 3, 3) }, "3 unknowns"},
+	}
+	for _, tt := range tests {
+		withLocale(t, tt.locale, func() {
+			if got := tt.got(); got != tt.want {
+				t.Errorf("%s: got %q, want %q", tt.locale, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRightToLeft(t *testing.T) {
+	for locale, want := range map[string]bool{"en": false, "fr-FR": false, "ar": true, "ar-TN": true} {
+		if got := IsRightToLeft(locale); got != want {
+			t.Errorf("IsRightToLeft(%q) = %v, want %v", locale, got, want)
+		}
+	}
+}
+
+func TestFormatNumbers(t *testing.T) {
+	tests := []struct {
+		locale string
+		got    func() string
+		want   string
+	}{
+		{"en", func() string { return FormatNumber(12500) }, "12,500"},
+		{"fr", func() string { return FormatNumber(12500) }, "12 500"},
+		{"ar", func() string { return FormatNumber(12500) }, "12.500"},
+		{"en", func() string { return FormatNumber(-1234567) }, "-1,234,567"},
+		{"en", func() string { return FormatDecimal(4.86, 1) }, "4.9"},
+		{"fr", func() string { return FormatDecimal(4.86, 1) }, "4,9"},
+		{"ar", func() string { return FormatDecimal(1234.5, 2) }, "1.234,50"},
+		{"fr", func() string { return FormatDecimal(-0.01, 1) }, "0,0"},
+	}
+	for _, tt := range tests {
+		withLocale(t, tt.locale, func() {
+			if got := tt.got(); got != tt.want {
+				t.Errorf("%s: got %q, want %q", tt.locale, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormatDate(t *testing.T) {
+	day := time.Date(2026, time.March, 2, 14, 30, 0, 0, time.UTC) // A Monday
+	tests := []struct {
+		locale, layout string
+		want           string
+	}{
+		{"en", "Mon 02 Jan 15:04", "Mon 02 Mar 14:30"},
+		{"fr", "Mon 02 Jan 15:04", "lun. 02 mars 14:30"},
+		{"fr", "Monday 2 January 2006", "lundi 2 mars 2026"},
+		{"ar", "Mon 02 Jan", "الإثنين 02 مارس"},
+		{"ar", "02 Jan 2006", "02 مارس 2026"},
+	}
+	for _, tt := range tests {
+		withLocale(t, tt.locale, func() {
+			if got := FormatDate(day, tt.layout); got != tt.want {
+				t.Errorf("%s: FormatDate(%q) = %q, want %q", tt.locale, tt.layout, got, tt.want)
+			}
+		})
+	}
+}
+
+var verbPattern = regexp.MustCompile(`%(?:\[(\d+)\])?[-+# 0]*\d*(?:\.\d+)?([a-zA-Z%])`)
+
+// verbs lists the fmt verbs of a message by argument, so translations can be
+// checked to take the same arguments. %[2]s counts as the second argument.
+func verbs(text string) []string {
+	var found []string
+	next := 1
+	for _, m := range verbPattern.FindAllStringSubmatch(text, -1) {
+		if m[2] == "%" {
+			continue
+		}
+		index := next
+		if m[1] != "" {
+			index, _ = strconv.Atoi(m[1])
+		}
+		found = append(found, strconv.Itoa(index)+m[2])
+		next = index + 1
+	}
+	sort.Strings(found)
+	return found
+}
+
+func sameVerbs(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func TestCatalogsMatch(t *testing.T) {
+	for _, lang := range Supported[1:] {
+		catalog := catalogs[lang]
+		for key, m := range catalog {
+			if m.plural == nil {
+				if !sameVerbs(verbs(key), verbs(m.text)) {
+					t.Errorf("%s: %q translated as %q takes other arguments", lang, key, m.text)
+				}
+				continue
+			}
+			if _, ok := m.plural[PluralOther]; !ok {
+				t.Errorf("%s: %q has no %q form", lang, key, PluralOther)
+			}
+			for form, text := range m.plural {
+				// Forms may spell the count out and leave the verb away
+				if got := verbs(text); len(got) > 0 && !sameVerbs(verbs(key), got) {
+					t.Errorf("%s: %q form %q is %q, which takes other arguments", lang, key, form, text)
+				}
+			}
+		}
+		for _, other := range Supported[1:] {
+			for key := range catalogs[other] {
+				if _, ok := catalog[key]; !ok {
+					t.Errorf("%s: %q is translated in %s only", lang, key, other)
+				}
+			}
+		}
+	}
+}
+
+// TestMessagesTranslated checks that every message the app passes to T or N
+// as a literal has a translation in every language
+func TestMessagesTranslated(t *testing.T) {
+	files, err := filepath.Glob("../ui/*.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	files = append(files, "../../main.go")
+
+	fset := token.NewFileSet()
+	checked := 0
+	for _, file := range files {
+		f, err := parser.ParseFile(fset, file, nil, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ast.Inspect(f, func(n ast.Node) bool {
+			call, ok := n.(*ast.CallExpr)
+			if !ok || len(call.Args) == 0 {
+				return true
+			}
+			sel, ok := call.Fun.(*ast.SelectorExpr)
+			if !ok || sel.Sel.Name != "T" && sel.Sel.Name != "N" {
+				return true
+			}
+			if pkg, ok := sel.X.(*ast.Ident); !ok || pkg.Name != "i18n" {
+				return true
+			}
+			lit, ok := call.Args[0].(*ast.BasicLit)
+			if !ok || lit.Kind != token.STRING {
+				return true
+			}
+			key, err := strconv.Unquote(lit.Value)
+			if err != nil {
+				t.Fatal(err)
+			}
+			checked++
+			for _, lang := range Supported[1:] {
+				m, ok := catalogs[lang][key]
+				switch {
+				case !ok:
+					t.Errorf("%s: %q is not translated to %s", fset.Position(lit.Pos()), key, lang)
+				case sel.Sel.Name == "N" && m.plural == nil:
+					t.Errorf("%s: %q has no plural forms in %s", fset.Position(lit.Pos()), key, lang)
+				}
+			}
+			return true
+		})
+	}
+	if checked == 0 {
+		t.Fatal("no translated messages found in the app")
+	}
+}
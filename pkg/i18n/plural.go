@@ -0,0 +1,46 @@
+package i18n
+
+// PluralForm is a CLDR plural category
+type PluralForm string
+
+const (
+	PluralZero  PluralForm = "zero"
+	PluralOne   PluralForm = "one"
+	PluralTwo   PluralForm = "two"
+	PluralFew   PluralForm = "few"
+	PluralMany  PluralForm = "many"
+	PluralOther PluralForm = "other"
+)
+
+// PluralFormOf returns the plural category a language uses for a count,
+// following the CLDR rules for whole numbers
+func PluralFormOf(lang string, n int) PluralForm {
+	if n < 0 {
+		n = -n
+	}
+	switch language(lang) {
+	case "fr":
+		// 0 and 1 are singular in French
+		if n <= 1 {
+			return PluralOne
+		}
+	case "ar":
+		switch mod := n % 100; {
+		case n == 0:
+			return PluralZero
+		case n == 1:
+			return PluralOne
+		case n == 2:
+			return PluralTwo
+		case mod >= 3 && mod <= 10:
+			return PluralFew
+		case mod >= 11 && mod <= 99:
+			return PluralMany
+		}
+	default:
+		if n == 1 {
+			return PluralOne
+		}
+	}
+	return PluralOther
+}
@@ -0,0 +1,49 @@
+package images
+
+import (
+	"image"
+	"image/color"
+	"image/draw"
+	"math"
+)
+
+// CropSquare returns the largest centered square of an image
+func CropSquare(img image.Image) image.Image {
+	b := img.Bounds()
+	side := min(b.Dx(), b.Dy())
+	x := b.Min.X + (b.Dx()-side)/2
+	y := b.Min.Y + (b.Dy()-side)/2
+	return CropRect(img, image.Rect(x, y, x+side, y+side))
+}
+
+// CropRect returns a copy of part of an image, clipped to its bounds
+func CropRect(img image.Image, r image.Rectangle) image.Image {
+	r = r.Intersect(img.Bounds())
+	out := image.NewNRGBA(image.Rect(0, 0, r.Dx(), r.Dy()))
+	draw.Draw(out, out.Bounds(), img, r.Min, draw.Src)
+	return out
+}
+
+// CropCircle returns the centered square of an image with the area outside
+// the inscribed circle made transparent. The edge is anti-aliased.
+func CropCircle(img image.Image) image.Image {
+	square := CropSquare(img)
+	side := square.Bounds().Dx()
+	out := image.NewNRGBA(image.Rect(0, 0, side, side))
+	radius := float64(side) / 2
+
+	for y := 0; y < side; y++ {
+		for x := 0; x < side; x++ {
+			dx := float64(x) + 0.5 - radius
+			dy := float64(y) + 0.5 - radius
+			coverage := math.Max(0, math.Min(1, radius-math.Hypot(dx, dy)+0.5))
+			if coverage == 0 {
+				continue
+			}
+			c := color.NRGBAModel.Convert(square.At(x, y)).(color.NRGBA)
+			c.A = uint8(float64(c.A) * coverage)
+			out.SetNRGBA(x, y, c)
+		}
+	}
+	return out
+}
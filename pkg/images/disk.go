@@ -0,0 +1,135 @@
+package images
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DiskCache keeps downloaded image files with their ETag in a directory.
+// When the files exceed the size limit, the least recently used are deleted.
+type DiskCache struct {
+	mu       sync.Mutex
+	dir      string
+	maxBytes int64
+}
+
+// NewDiskCache creates the cache directory if needed
+func NewDiskCache(dir string, maxBytes int64) (*DiskCache, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	return &DiskCache{dir: dir, maxBytes: maxBytes}, nil
+}
+
+func (c *DiskCache) path(url string) string {
+	sum := sha256.Sum256([]byte(url))
+	return filepath.Join(c.dir, hex.EncodeToString(sum[:16]))
+}
+
+// Get returns a cached file and its ETag, ok is false if it is not cached
+func (c *DiskCache) Get(url string) (data []byte, etag string, ok bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	p := c.path(url)
+	data, err := os.ReadFile(p + ".img")
+	if err != nil {
+		return nil, "", false
+	}
+	if tag, err := os.ReadFile(p + ".etag"); err == nil {
+		etag = string(tag)
+	}
+	// The modification time tracks use for eviction
+	now := time.Now()
+	_ = os.Chtimes(p+".img", now, now)
+	return data, etag, true
+}
+
+// Touch marks a cached file as recently used, e.g. after a revalidation
+func (c *DiskCache) Touch(url string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	now := time.Now()
+	_ = os.Chtimes(c.path(url)+".img", now, now)
+}
+
+// Put stores a file and its ETag, then trims the cache to its size limit
+func (c *DiskCache) Put(url string, data []byte, etag string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	p := c.path(url)
+	if err := os.WriteFile(p+".img", data, 0o644); err != nil {
+		return err
+	}
+	if etag == "" {
+		_ = os.Remove(p + ".etag")
+	} else if err := os.WriteFile(p+".etag", []byte(etag), 0o644); err != nil {
+		return err
+	}
+	return c.trim()
+}
+
+// Size returns the total size of the cached files
+func (c *DiskCache) Size() int64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	files, _ := c.files()
+	var total int64
+	for _, f := range files {
+		total += f.size
+	}
+	return total
+}
+
+type cachedFile struct {
+	path    string
+	size    int64
+	modTime time.Time
+}
+
+func (c *DiskCache) files() ([]cachedFile, error) {
+	entries, err := os.ReadDir(c.dir)
+	if err != nil {
+		return nil, err
+	}
+	var files []cachedFile
+	for _, e := range entries {
+		if !strings.HasSuffix(e.Name(), ".img") {
+			continue
+		}
+		info, err := e.Info()
+		if err != nil {
+			continue
+		}
+		files = append(files, cachedFile{filepath.Join(c.dir, e.Name()), info.Size(), info.ModTime()})
+	}
+	return files, nil
+}
+
+// trim deletes the least recently used files until the cache fits its limit
+func (c *DiskCache) trim() error {
+	files, err := c.files()
+	if err != nil {
+		return err
+	}
+	var total int64
+	for _, f := range files {
+		total += f.size
+	}
+	sort.Slice(files, func(i, j int) bool { return files[i].modTime.Before(files[j].modTime) })
+	for _, f := range files {
+		if total <= c.maxBytes {
+			break
+		}
+		base := strings.TrimSuffix(f.path, ".img")
+		_ = os.Remove(f.path)
+		_ = os.Remove(base + ".etag")
+		total -= f.size
+	}
+	return nil
+}
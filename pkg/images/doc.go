@@ -0,0 +1,5 @@
+// Package images loads remote pictures such as avatars and portfolio photos.
+// Decoded images are kept in a memory LRU cache and the downloaded files in
+// a size-bounded disk cache, revalidated with ETags. It also provides the
+// crop, resize and encode steps used before upload.
+package images
@@ -0,0 +1,80 @@
+package images
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	"image/jpeg"
+	"image/png"
+
+	"golang.org/x/image/draw"
+)
+
+// ErrCannotCompress is returned when an image stays over the size limit
+// at the lowest quality
+var ErrCannotCompress = errors.New("images: image cannot be compressed enough")
+
+// JPEG qualities tried by Encode, from best to smallest
+var jpegQualities = []int{85, 75, 65, 55, 45}
+
+// Resize scales an image down so its longer side is at most maxSide pixels.
+// Smaller images are returned unchanged.
+func Resize(img image.Image, maxSide int) image.Image {
+	b := img.Bounds()
+	if b.Dx() <= maxSide && b.Dy() <= maxSide {
+		return img
+	}
+	w, h := maxSide, maxSide
+	if b.Dx() > b.Dy() {
+		h = max(1, b.Dy()*maxSide/b.Dx())
+	} else {
+		w = max(1, b.Dx()*maxSide/b.Dy())
+	}
+	out := image.NewNRGBA(image.Rect(0, 0, w, h))
+	draw.CatmullRom.Scale(out, out.Bounds(), img, b, draw.Src, nil)
+	return out
+}
+
+// Encode compresses an image for upload and returns the data with its file
+// extension. Images with transparency, such as circle crops, are PNG, the
+// others JPEG at the best quality that fits in maxBytes.
+func Encode(img image.Image, maxBytes int) ([]byte, string, error) {
+	var buf bytes.Buffer
+	if !isOpaque(img) {
+		enc := png.Encoder{CompressionLevel: png.BestCompression}
+		if err := enc.Encode(&buf, img); err != nil {
+			return nil, "", err
+		}
+		if buf.Len() > maxBytes {
+			return nil, "", ErrCannotCompress
+		}
+		return buf.Bytes(), ".png", nil
+	}
+
+	for _, quality := range jpegQualities {
+		buf.Reset()
+		if err := jpeg.Encode(&buf, img, &jpeg.Options{Quality: quality}); err != nil {
+			return nil, "", err
+		}
+		if buf.Len() <= maxBytes {
+			return buf.Bytes(), ".jpg", nil
+		}
+	}
+	return nil, "", ErrCannotCompress
+}
+
+// isOpaque reports whether an image has no transparent pixels
+func isOpaque(img image.Image) bool {
+	if o, ok := img.(interface{ Opaque() bool }); ok {
+		return o.Opaque()
+	}
+	b := img.Bounds()
+	for y := b.Min.Y; y < b.Max.Y; y++ {
+		for x := b.Min.X; x < b.Max.X; x++ {
+			if _, _, _, a := img.At(x, y).RGBA(); a != 0xffff {
+				return false
+			}
+		}
+	}
+	return true
+}
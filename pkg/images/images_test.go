@@ -0,0 +1,310 @@
+package images
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"image"
+	"image/color"
+	"image/png"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func solid(w, h int) image.Image {
+	img := image.NewNRGBA(image.Rect(0, 0, w, h))
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			img.SetNRGBA(x, y, color.NRGBA{R: 200, G: 100, B: 50, A: 255})
+		}
+	}
+	return img
+}
+
+func pngBytes(t *testing.T, img image.Image) []byte {
+	t.Helper()
+	var b bytes.Buffer
+	if err := png.Encode(&b, img); err != nil {
+		t.Fatal(err)
+	}
+	return b.Bytes()
+}
+
+func TestMemoryCacheEvictsLeastRecentlyUsed(t *testing.T) {
+	c := NewMemoryCache(3 * 10 * 10 * 4) // Room for three 10x10 images
+	c.Add("a", solid(10, 10))
+	c.Add("b", solid(10, 10))
+	c.Add("c", solid(10, 10))
+	c.Get("a") // b is now the least recently used
+	c.Add("d", solid(10, 10))
+
+	tests := []struct {
+		key  string
+		want bool
+	}{
+		{"a", true},
+		{"b", false},
+		{"c", true},
+		{"d", true},
+	}
+	for _, tt := range tests {
+		if _, ok := c.Get(tt.key); ok != tt.want {
+			t.Errorf("Get(%q) ok = %v, want %v", tt.key, ok, tt.want)
+		}
+	}
+
+	c.Add("huge", solid(100, 100))
+	if _, ok := c.Get("huge"); ok || c.Len() != 3 {
+		t.Errorf("an image larger than the cache was kept, len = %d", c.Len())
+	}
+}
+
+func TestDiskCacheSizeBound(t *testing.T) {
+	dir := t.TempDir()
+	c, err := NewDiskCache(dir, 250)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Set the use order explicitly, u1 is the oldest, storing u3 evicts it
+	old := time.Now().Add(-time.Hour)
+	for i, url := range []string{"u1", "u2", "u3"} {
+		if err := c.Put(url, make([]byte, 100), "tag"); err != nil {
+			t.Fatal(err)
+		}
+		at := old.Add(time.Duration(i) * time.Minute)
+		os.Chtimes(c.path(url)+".img", at, at)
+	}
+
+	if size := c.Size(); size > 250 {
+		t.Errorf("cache size = %d, want at most 250", size)
+	}
+	if _, _, ok := c.Get("u1"); ok {
+		t.Error("least recently used file was kept")
+	}
+	if _, tag, ok := c.Get("u3"); !ok || tag != "tag" {
+		t.Errorf("Get(u3) = %q, %v, want tag, true", tag, ok)
+	}
+	leftovers, _ := filepath.Glob(filepath.Join(dir, "*.etag"))
+	if len(leftovers) != 2 {
+		t.Errorf("%d etag files left, want 2", len(leftovers))
+	}
+}
+
+func TestLoaderRevalidatesWithETag(t *testing.T) {
+	body := pngBytes(t, solid(4, 4))
+	var full, notModified atomic.Int32
+	offline := atomic.Bool{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if offline.Load() {
+			http.Error(w, "down", http.StatusServiceUnavailable)
+			return
+		}
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			notModified.Add(1)
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		full.Add(1)
+		w.Header().Set("ETag", `"v1"`)
+		w.Write(body)
+	}))
+	defer srv.Close()
+
+	disk, err := NewDiskCache(t.TempDir(), 1<<20)
+	if err != nil {
+		t.Fatal(err)
+	}
+	url := srv.URL + "/avatar.png"
+
+	// First load downloads, a second loader with an empty memory revalidates
+	for _, l := range []*Loader{
+		{Memory: NewMemoryCache(1 << 20), Disk: disk},
+		{Memory: NewMemoryCache(1 << 20), Disk: disk},
+	} {
+		if _, err := l.Load(context.Background(), url); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := l.Load(context.Background(), url); err != nil { // From memory
+			t.Fatal(err)
+		}
+	}
+	if full.Load() != 1 || notModified.Load() != 1 {
+		t.Errorf("downloads = %d, revalidations = %d, want 1 and 1", full.Load(), notModified.Load())
+	}
+
+	// Offline, the disk copy is used
+	offline.Store(true)
+	l := &Loader{Disk: disk}
+	img, err := l.Load(context.Background(), url)
+	if err != nil || img.Bounds().Dx() != 4 {
+		t.Errorf("offline load = %v, %v", img, err)
+	}
+	if _, err := l.Load(context.Background(), srv.URL+"/missing.png"); err == nil {
+		t.Error("uncached image loaded while offline")
+	}
+}
+
+func TestLoaderCacheFirst(t *testing.T) {
+	body := pngBytes(t, solid(4, 4))
+	var requests atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.Header().Set("ETag", `"v1"`)
+		w.Write(body)
+	}))
+	defer srv.Close()
+
+	disk, err := NewDiskCache(t.TempDir(), 1<<20)
+	if err != nil {
+		t.Fatal(err)
+	}
+	url := srv.URL + "/avatar.png"
+	if _, err := (&Loader{Disk: disk}).Load(context.Background(), url); err != nil {
+		t.Fatal(err)
+	}
+
+	// A cached image is used without asking the server, others still download
+	l := &Loader{Disk: disk}
+	l.CacheFirst.Store(true)
+	if _, err := l.Load(context.Background(), url); err != nil {
+		t.Fatal(err)
+	}
+	if requests.Load() != 1 {
+		t.Errorf("requests = %d, want 1", requests.Load())
+	}
+	if _, err := l.Load(context.Background(), srv.URL+"/other.png"); err != nil {
+		t.Fatal(err)
+	}
+	if requests.Load() != 2 {
+		t.Errorf("requests = %d, want 2", requests.Load())
+	}
+}
+
+func TestLoaderCancel(t *testing.T) {
+	release := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-release:
+		case <-r.Context().Done():
+		}
+	}))
+	defer srv.Close()
+	defer close(release)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() {
+		_, err := (&Loader{}).Load(ctx, srv.URL)
+		done <- err
+	}()
+	cancel()
+
+	select {
+	case err := <-done:
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("err = %v, want context.Canceled", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("load was not cancelled")
+	}
+}
+
+func TestCropCircle(t *testing.T) {
+	img := CropCircle(solid(40, 20))
+	if b := img.Bounds(); b.Dx() != 20 || b.Dy() != 20 {
+		t.Fatalf("size = %v, want 20x20", b)
+	}
+	tests := []struct {
+		x, y  int
+		alpha uint8
+	}{
+		{0, 0, 0},     // Corner, outside the circle
+		{19, 19, 0},   // Opposite corner
+		{10, 10, 255}, // Center
+		{10, 1, 255},  // Just inside the top edge
+	}
+	for _, tt := range tests {
+		_, _, _, a := img.At(tt.x, tt.y).RGBA()
+		if uint8(a>>8) != tt.alpha {
+			t.Errorf("alpha at (%d,%d) = %d, want %d", tt.x, tt.y, a>>8, tt.alpha)
+		}
+	}
+}
+
+func TestCropSquare(t *testing.T) {
+	tests := []struct {
+		w, h, want int
+	}{
+		{40, 20, 20},
+		{20, 40, 20},
+		{30, 30, 30},
+	}
+	for _, tt := range tests {
+		b := CropSquare(solid(tt.w, tt.h)).Bounds()
+		if b.Dx() != tt.want || b.Dy() != tt.want {
+			t.Errorf("CropSquare(%dx%d) = %v, want %dx%d", tt.w, tt.h, b, tt.want, tt.want)
+		}
+	}
+}
+
+func TestResize(t *testing.T) {
+	tests := []struct {
+		w, h, max    int
+		wantW, wantH int
+	}{
+		{2000, 1000, 512, 512, 256},
+		{1000, 2000, 512, 256, 512},
+		{300, 200, 512, 300, 200}, // Never upscaled
+		{4000, 1, 512, 512, 1},
+	}
+	for _, tt := range tests {
+		b := Resize(solid(tt.w, tt.h), tt.max).Bounds()
+		if b.Dx() != tt.wantW || b.Dy() != tt.wantH {
+			t.Errorf("Resize(%dx%d, %d) = %dx%d, want %dx%d", tt.w, tt.h, tt.max, b.Dx(), b.Dy(), tt.wantW, tt.wantH)
+		}
+	}
+}
+
+func TestEncode(t *testing.T) {
+	noisy := image.NewNRGBA(image.Rect(0, 0, 256, 256))
+	for i := range noisy.Pix {
+		noisy.Pix[i] = uint8(i * 7919 % 251)
+	}
+	for i := 3; i < len(noisy.Pix); i += 4 {
+		noisy.Pix[i] = 255
+	}
+
+	tests := []struct {
+		name     string
+		img      image.Image
+		maxBytes int
+		wantExt  string
+		wantErr  bool
+	}{
+		{"opaque photo is JPEG", solid(64, 64), 100 << 10, ".jpg", false},
+		{"circle crop keeps transparency", CropCircle(solid(64, 64)), 100 << 10, ".png", false},
+		{"lower quality to fit", noisy, 60 << 10, ".jpg", false},
+		{"too small a limit", noisy, 100, "", true},
+	}
+	for _, tt := range tests {
+		data, ext, err := Encode(tt.img, tt.maxBytes)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("%s: error = %v, wantErr %v", tt.name, err, tt.wantErr)
+			continue
+		}
+		if err != nil {
+			continue
+		}
+		if ext != tt.wantExt || len(data) > tt.maxBytes {
+			t.Errorf("%s: got %s of %d bytes, want %s under %d", tt.name, ext, len(data), tt.wantExt, tt.maxBytes)
+		}
+		if _, _, err := image.Decode(bytes.NewReader(data)); err != nil {
+			t.Errorf("%s: output does not decode: %v", tt.name, err)
+		}
+	}
+}
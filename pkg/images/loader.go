@@ -0,0 +1,119 @@
+package images
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"image"
+	_ "image/jpeg" // Decoders for the formats served by the backend
+	_ "image/png"
+	"io"
+	"net/http"
+	"sync/atomic"
+)
+
+// MaxDownloadSize is the largest image accepted from the network
+const MaxDownloadSize = 10 << 20 // 10 MB
+
+// ErrTooLarge is returned for images over MaxDownloadSize
+var ErrTooLarge = errors.New("images: image too large")
+
+// Loader fetches and decodes images through the memory and disk caches.
+// Disk and Memory are optional.
+type Loader struct {
+	Client *http.Client
+	Memory *MemoryCache
+	Disk   *DiskCache
+
+	// CacheFirst uses an image on disk without revalidating it, to save data
+	CacheFirst atomic.Bool
+}
+
+// Load returns the image at url. An image in memory is returned as is,
+// one on disk is revalidated with its ETag, and a cached copy is used when
+// the network fails. Cancelling ctx abandons the download.
+func (l *Loader) Load(ctx context.Context, url string) (image.Image, error) {
+	if l.Memory != nil {
+		if img, ok := l.Memory.Get(url); ok {
+			return img, nil
+		}
+	}
+
+	var cached []byte
+	var etag string
+	if l.Disk != nil {
+		cached, etag, _ = l.Disk.Get(url)
+	}
+	if cached != nil && l.CacheFirst.Load() {
+		img, _, err := image.Decode(bytes.NewReader(cached))
+		if err == nil {
+			l.Disk.Touch(url)
+			if l.Memory != nil {
+				l.Memory.Add(url, img)
+			}
+			return img, nil
+		}
+	}
+
+	data, newETag, err := l.fetch(ctx, url, etag)
+	switch {
+	case errors.Is(err, errNotModified):
+		data = cached
+		l.Disk.Touch(url)
+	case err != nil:
+		if cached == nil || ctx.Err() != nil {
+			return nil, err
+		}
+		data = cached // Offline, the cached copy is better than nothing
+	}
+
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("images: decode %s: %w", url, err)
+	}
+	if l.Disk != nil && data != nil && !bytes.Equal(data, cached) {
+		_ = l.Disk.Put(url, data, newETag)
+	}
+	if l.Memory != nil {
+		l.Memory.Add(url, img)
+	}
+	return img, nil
+}
+
+var errNotModified = errors.New("images: not modified")
+
+// fetch downloads url, returning errNotModified if etag still matches
+func (l *Loader) fetch(ctx context.Context, url, etag string) ([]byte, string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, "", err
+	}
+	if etag != "" {
+		req.Header.Set("If-None-Match", etag)
+	}
+	client := l.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, "", err
+	}
+	defer resp.Body.Close()
+
+	switch {
+	case resp.StatusCode == http.StatusNotModified && etag != "":
+		return nil, etag, errNotModified
+	case resp.StatusCode != http.StatusOK:
+		return nil, "", fmt.Errorf("images: GET %s: %s", url, resp.Status)
+	}
+	data, err := io.ReadAll(io.LimitReader(resp.Body, MaxDownloadSize+1))
+	if err != nil {
+		return nil, "", err
+	}
+	if len(data) > MaxDownloadSize {
+		return nil, "", ErrTooLarge
+	}
+	return data, resp.Header.Get("ETag"), nil
+}
@@ -0,0 +1,86 @@
+package images
+
+import (
+	"container/list"
+	"image"
+	"sync"
+)
+
+// MemoryCache is an LRU cache of decoded images bounded by their pixel size
+type MemoryCache struct {
+	mu       sync.Mutex
+	maxBytes int
+	used     int
+	order    *list.List // Front is the most recently used
+	items    map[string]*list.Element
+}
+
+type memoryEntry struct {
+	key  string
+	img  image.Image
+	size int
+}
+
+// NewMemoryCache creates a cache holding up to maxBytes of decoded pixels
+func NewMemoryCache(maxBytes int) *MemoryCache {
+	return &MemoryCache{maxBytes: maxBytes, order: list.New(), items: map[string]*list.Element{}}
+}
+
+// imageBytes estimates the memory used by a decoded image
+func imageBytes(img image.Image) int {
+	b := img.Bounds()
+	return b.Dx() * b.Dy() * 4
+}
+
+// Get returns a cached image and marks it as recently used
+func (c *MemoryCache) Get(key string) (image.Image, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	el, ok := c.items[key]
+	if !ok {
+		return nil, false
+	}
+	c.order.MoveToFront(el)
+	return el.Value.(*memoryEntry).img, true
+}
+
+// Add caches an image, evicting the least recently used ones to make room.
+// Images larger than the whole cache are not kept.
+func (c *MemoryCache) Add(key string, img image.Image) {
+	size := imageBytes(img)
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if el, ok := c.items[key]; ok {
+		c.remove(el)
+	}
+	if size > c.maxBytes {
+		return
+	}
+	c.items[key] = c.order.PushFront(&memoryEntry{key: key, img: img, size: size})
+	c.used += size
+	for c.used > c.maxBytes {
+		c.remove(c.order.Back())
+	}
+}
+
+// Remove drops an image from the cache
+func (c *MemoryCache) Remove(key string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if el, ok := c.items[key]; ok {
+		c.remove(el)
+	}
+}
+
+// Len returns the number of cached images
+func (c *MemoryCache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.items)
+}
+
+func (c *MemoryCache) remove(el *list.Element) {
+	entry := c.order.Remove(el).(*memoryEntry)
+	delete(c.items, entry.key)
+	c.used -= entry.size
+}
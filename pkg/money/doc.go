@@ -0,0 +1,4 @@
+// Package money represents amounts as integer minor units with a currency code,
+// so prices and balances add up without floating point rounding errors.
+// The Tunisian dinar has three decimals: 1 TND = 1000 millimes.
+package money
@@ -0,0 +1,308 @@
+package money
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync/atomic"
+)
+
+// Currency is an ISO 4217 currency code
+type Currency string
+
+const (
+	TND Currency = "TND" // Tunisian dinar, 3 decimals
+	EUR Currency = "EUR"
+	USD Currency = "USD"
+)
+
+// Decimals returns the number of minor unit digits of the currency
+func (c Currency) Decimals() int {
+	switch c {
+	case TND, "":
+		return 3
+	default:
+		return 2
+	}
+}
+
+// scale returns how many minor units make one major unit
+func (c Currency) scale() int64 {
+	s := int64(1)
+	for i := 0; i < c.Decimals(); i++ {
+		s *= 10
+	}
+	return s
+}
+
+// Symbol returns the symbol used for the currency in a locale
+func (c Currency) Symbol(locale string) string {
+	switch c {
+	case TND, "":
+		switch language(locale) {
+		case "fr":
+			return "DT"
+		case "ar":
+			return "د.ت"
+		}
+		return "TND"
+	case EUR:
+		return "€"
+	case USD:
+		return "$"
+	}
+	return string(c)
+}
+
+// ErrUnsupportedCurrency is returned when decoding an amount that is not in
+// TND, the currency the app charges in
+var ErrUnsupportedCurrency = errors.New("money: unsupported currency")
+
+// Money is an amount in minor units of a currency (millimes for TND).
+// The zero value is 0 TND.
+type Money struct {
+	Amount   int64    `json:"amount"` // Minor units
+	Currency Currency `json:"currency"`
+}
+
+// UnmarshalJSON reads {"amount": 45000, "currency": "TND"}. Older responses
+// and caches hold a bare number of dinars instead, e.g. an hourly rate of 45.
+// Amounts in another currency are rejected with ErrUnsupportedCurrency, so
+// amounts from the server can always be added and compared.
+func (m *Money) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+	if string(data) == "null" {
+		return nil
+	}
+	if len(data) > 0 && data[0] != '{' {
+		var n json.Number
+		if err := json.Unmarshal(data, &n); err != nil {
+			return fmt.Errorf("money: invalid amount %s", data)
+		}
+		legacy, err := Parse(n.String(), TND)
+		if err != nil {
+			return fmt.Errorf("money: %w", err)
+		}
+		*m = legacy
+		return nil
+	}
+
+	type plain Money // Without this method
+	var p plain
+	if err := json.Unmarshal(data, &p); err != nil {
+		return err
+	}
+	if p.Currency != "" && p.Currency != TND {
+		return fmt.Errorf("%w %q", ErrUnsupportedCurrency, p.Currency)
+	}
+	*m = Money(p)
+	return nil
+}
+
+// New creates an amount from minor units
+func New(minor int64, currency Currency) Money {
+	return Money{Amount: minor, Currency: currency}
+}
+
+// Millimes creates a TND amount from millimes
+func Millimes(m int64) Money {
+	return New(m, TND)
+}
+
+// Dinars creates a TND amount from whole dinars
+func Dinars(d int64) Money {
+	return New(d*TND.scale(), TND)
+}
+
+// Parse reads a decimal amount such as "150", "150.5" or "150,500".
+// Negative amounts and more decimals than the currency allows are rejected.
+func Parse(s string, currency Currency) (Money, error) {
+	s = strings.TrimSpace(s)
+	whole, frac := s, ""
+	if i := strings.IndexAny(s, ".,"); i >= 0 {
+		whole, frac = s[:i], s[i+1:]
+	}
+	if whole == "" || len(frac) > currency.Decimals() || strings.ContainsAny(whole+frac, "+-") {
+		return Money{}, fmt.Errorf("invalid amount %q", s)
+	}
+
+	w, err := strconv.ParseInt(whole, 10, 64)
+	if err != nil {
+		return Money{}, fmt.Errorf("invalid amount %q", s)
+	}
+	var f int64
+	if frac != "" {
+		frac += strings.Repeat("0", currency.Decimals()-len(frac))
+		if f, err = strconv.ParseInt(frac, 10, 64); err != nil {
+			return Money{}, fmt.Errorf("invalid amount %q", s)
+		}
+	}
+	return New(w*currency.scale()+f, currency), nil
+}
+
+// Cur returns the currency, TND when unset
+func (m Money) Cur() Currency {
+	if m.Currency == "" {
+		return TND
+	}
+	return m.Currency
+}
+
+// mustMatch panics when two amounts of different currencies are combined.
+// Decoded amounts are always in TND, so this is a programming error.
+func (m Money) mustMatch(other Money) {
+	if m.Cur() != other.Cur() {
+		panic(fmt.Sprintf("money: currency mismatch %s and %s", m.Cur(), other.Cur()))
+	}
+}
+
+// Add returns m + other, both must be in the same currency
+func (m Money) Add(other Money) Money {
+	m.mustMatch(other)
+	return New(m.Amount+other.Amount, m.Cur())
+}
+
+// Sub returns m - other, both must be in the same currency
+func (m Money) Sub(other Money) Money {
+	m.mustMatch(other)
+	return New(m.Amount-other.Amount, m.Cur())
+}
+
+// Mul returns m multiplied by a quantity, e.g. an hourly rate by hours
+func (m Money) Mul(n int64) Money {
+	return New(m.Amount*n, m.Cur())
+}
+
+// Percent returns the given share of m in basis points (1500 = 15%),
+// rounded half away from zero to the minor unit
+func (m Money) Percent(basisPoints int) Money {
+	p := m.Amount * int64(basisPoints)
+	if p < 0 {
+		return New((p-5000)/10000, m.Cur())
+	}
+	return New((p+5000)/10000, m.Cur())
+}
+
+// Neg returns -m
+func (m Money) Neg() Money {
+	return New(-m.Amount, m.Cur())
+}
+
+// IsZero reports whether the amount is zero
+func (m Money) IsZero() bool {
+	return m.Amount == 0
+}
+
+// IsNegative reports whether the amount is below zero
+func (m Money) IsNegative() bool {
+	return m.Amount < 0
+}
+
+// Cmp returns -1, 0 or 1 when m is less than, equal to or greater than other
+func (m Money) Cmp(other Money) int {
+	m.mustMatch(other)
+	switch {
+	case m.Amount < other.Amount:
+		return -1
+	case m.Amount > other.Amount:
+		return 1
+	}
+	return 0
+}
+
+// Decimal returns the amount without symbol or grouping, e.g. "152.500",
+// as used in form fields and exports
+func (m Money) Decimal() string {
+	return m.digits(".", "", true)
+}
+
+// Format returns the amount with all decimals for a locale, e.g.
+// "TND 1,152.500" (en), "1 152,500 DT" (fr) or "1.152,500 د.ت" (ar)
+func (m Money) Format(locale string) string {
+	return m.format(locale, true)
+}
+
+// Compact is like Format but omits the decimals of whole amounts, e.g. "TND 180"
+func (m Money) Compact(locale string) string {
+	return m.format(locale, m.Amount%m.Cur().scale() != 0)
+}
+
+// String formats the amount for the current locale
+func (m Money) String() string {
+	return m.Format(Locale())
+}
+
+func (m Money) format(locale string, decimals bool) string {
+	f := localeFormat(locale)
+	amount := m.digits(f.decimal, f.group, decimals)
+	symbol := m.Cur().Symbol(locale)
+	if f.symbolFirst {
+		return symbol + " " + amount
+	}
+	return amount + " " + symbol
+}
+
+// digits renders the amount with the given separators
+func (m Money) digits(decimal, group string, decimals bool) string {
+	sign := ""
+	amount := m.Amount
+	if amount < 0 {
+		sign = "-"
+		amount = -amount
+	}
+	scale := m.Cur().scale()
+
+	whole := strconv.FormatInt(amount/scale, 10)
+	if group != "" {
+		for i := len(whole) - 3; i > 0; i -= 3 {
+			whole = whole[:i] + group + whole[i:]
+		}
+	}
+	if !decimals {
+		return sign + whole
+	}
+	return fmt.Sprintf("%s%s%s%0*d", sign, whole, decimal, m.Cur().Decimals(), amount%scale)
+}
+
+// numberFormat describes how a locale writes amounts
+type numberFormat struct {
+	decimal     string
+	group       string
+	symbolFirst bool
+}
+
+func localeFormat(locale string) numberFormat {
+	switch language(locale) {
+	case "fr":
+		return numberFormat{decimal: ",", group: " "}
+	case "ar":
+		return numberFormat{decimal: ",", group: "."}
+	}
+	return numberFormat{decimal: ".", group: ",", symbolFirst: true}
+}
+
+// language returns the language part of a locale such as "fr-TN"
+func language(locale string) string {
+	if i := strings.IndexAny(locale, "-_"); i >= 0 {
+		locale = locale[:i]
+	}
+	return strings.ToLower(locale)
+}
+
+var currentLocale atomic.Value
+
+// SetLocale sets the locale used by String
+func SetLocale(locale string) {
+	currentLocale.Store(locale)
+}
+
+// Locale returns the locale used by String, "en" by default
+func Locale() string {
+	if locale, ok := currentLocale.Load().(string); ok {
+		return locale
+	}
+	return "en"
+}
@@ -0,0 +1,187 @@
+package money
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		in       string
+		currency Currency
+		want     Money
+		wantErr  bool
+	}{
+		{"150", TND, Millimes(150000), false},
+		{"150.5", TND, Millimes(150500), false},
+		{"150,500", TND, Millimes(150500), false},
+		{" 0.001 ", TND, Millimes(1), false},
+		{"0", TND, Millimes(0), false},
+		{"12.34", EUR, New(1234, EUR), false},
+		{"12.345", EUR, Money{}, true}, // More decimals than the currency has
+		{"150.5001", TND, Money{}, true},
+		{"-5", TND, Money{}, true},
+		{"+5", TND, Money{}, true},
+		{"5.-1", TND, Money{}, true},
+		{".5", TND, Money{}, true},
+		{"", TND, Money{}, true},
+		{"abc", TND, Money{}, true},
+		{"1 000", TND, Money{}, true},
+	}
+	for _, tt := range tests {
+		got, err := Parse(tt.in, tt.currency)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("Parse(%q) error = %v, want error %v", tt.in, err, tt.wantErr)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("Parse(%q) = %+v, want %+v", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestPercent(t *testing.T) {
+	tests := []struct {
+		amount      Money
+		basisPoints int
+		want        Money
+	}{
+		{Dinars(100), 1500, Dinars(15)},
+		{Millimes(1), 5000, Millimes(1)},        // 0.5 millime rounds up
+		{Millimes(1), 4999, Millimes(0)},        // Just below half rounds down
+		{Millimes(10003), 1500, Millimes(1500)}, // 1500.45
+		{Millimes(10010), 1500, Millimes(1502)}, // 1501.5
+		{Millimes(-10010), 1500, Millimes(-1502)},
+		{Millimes(-1), 5000, Millimes(-1)},
+		{Dinars(80), 0, Dinars(0)},
+		{Dinars(80), 10000, Dinars(80)},
+	}
+	for _, tt := range tests {
+		if got := tt.amount.Percent(tt.basisPoints); got != tt.want {
+			t.Errorf("%d.Percent(%d) = %d, want %d", tt.amount.Amount, tt.basisPoints, got.Amount, tt.want.Amount)
+		}
+	}
+}
+
+func TestFormat(t *testing.T) {
+	tests := []struct {
+		amount      Money
+		locale      string
+		wantFormat  string
+		wantCompact string
+	}{
+		{Millimes(1152500), "en", "TND 1,152.500", "TND 1,152.500"},
+		{Millimes(1152500), "fr", "1\u202f152,500 DT", "1\u202f152,500 DT"},
+		{Millimes(1152500), "ar", "1.152,500 د.ت", "1.152,500 د.ت"},
+		{Dinars(180), "en", "TND 180.000", "TND 180"},
+		{Dinars(180), "fr-TN", "180,000 DT", "180 DT"},
+		{Dinars(180), "ar_TN", "180,000 د.ت", "180 د.ت"},
+		{Dinars(1000000), "en", "TND 1,000,000.000", "TND 1,000,000"},
+		{Millimes(5), "en", "TND 0.005", "TND 0.005"},
+		{Millimes(-2500), "en", "TND -2.500", "TND -2.500"},
+		{Millimes(-1234000), "fr", "-1\u202f234,000 DT", "-1\u202f234 DT"},
+		{Money{Amount: 45000}, "en", "TND 45.000", "TND 45"}, // No currency is TND
+		{New(1999, EUR), "en", "€ 19.99", "€ 19.99"},
+		{New(2000, USD), "fr", "20,00 $", "20 $"},
+	}
+	for _, tt := range tests {
+		if got := tt.amount.Format(tt.locale); got != tt.wantFormat {
+			t.Errorf("%+v.Format(%q) = %q, want %q", tt.amount, tt.locale, got, tt.wantFormat)
+		}
+		if got := tt.amount.Compact(tt.locale); got != tt.wantCompact {
+			t.Errorf("%+v.Compact(%q) = %q, want %q", tt.amount, tt.locale, got, tt.wantCompact)
+		}
+	}
+}
+
+func TestDecimal(t *testing.T) {
+	tests := []struct {
+		amount Money
+		want   string
+	}{
+		{Millimes(1152500), "1152.500"},
+		{Dinars(0), "0.000"},
+		{Millimes(-50), "-0.050"},
+		{New(1999, EUR), "19.99"},
+	}
+	for _, tt := range tests {
+		if got := tt.amount.Decimal(); got != tt.want {
+			t.Errorf("%+v.Decimal() = %q, want %q", tt.amount, got, tt.want)
+		}
+		if parsed, err := Parse(tt.want, tt.amount.Cur()); err == nil && parsed != tt.amount {
+			t.Errorf("Parse(%q) = %+v, want %+v back", tt.want, parsed, tt.amount)
+		}
+	}
+}
+
+func TestArithmetic(t *testing.T) {
+	a, b := Millimes(1500), Millimes(250)
+	if got := a.Add(b); got != Millimes(1750) {
+		t.Errorf("Add = %+v, want 1750 millimes", got)
+	}
+	if got := b.Sub(a); got != Millimes(-1250) || !got.IsNegative() {
+		t.Errorf("Sub = %+v, want -1250 millimes", got)
+	}
+	if got := a.Mul(3); got != Millimes(4500) {
+		t.Errorf("Mul = %+v, want 4500 millimes", got)
+	}
+	if a.Cmp(b) != 1 || b.Cmp(a) != -1 || a.Cmp(Millimes(1500)) != 0 {
+		t.Errorf("Cmp gave the wrong order")
+	}
+	if got := (Money{Amount: 10}).Add(Millimes(5)); got != Millimes(15) {
+		t.Errorf("Add without currency = %+v, want 15 millimes", got)
+	}
+}
+
+func TestUnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    Money
+		wantErr error // nil, ErrUnsupportedCurrency or errAny
+	}{
+		{`{"amount": 45000, "currency": "TND"}`, Millimes(45000), nil},
+		{`{"amount": 45000}`, Money{Amount: 45000}, nil},
+		{`45`, Dinars(45), nil}, // Legacy hourly rate in dinars
+		{`45.5`, Millimes(45500), nil},
+		{`0`, Dinars(0), nil},
+		{`null`, Money{}, nil},
+		{`{"amount": 1999, "currency": "EUR"}`, Money{}, ErrUnsupportedCurrency},
+		{`-5`, Money{}, errAny},
+		{`1e3`, Money{}, errAny},
+		{`true`, Money{}, errAny},
+	}
+	for _, tt := range tests {
+		var got Money
+		err := json.Unmarshal([]byte(tt.in), &got)
+		switch {
+		case tt.wantErr == nil && err != nil:
+			t.Errorf("Unmarshal(%s) error = %v", tt.in, err)
+		case tt.wantErr == errAny && err == nil, tt.wantErr == ErrUnsupportedCurrency && !errors.Is(err, ErrUnsupportedCurrency):
+			t.Errorf("Unmarshal(%s) error = %v, want %v", tt.in, err, tt.wantErr)
+		case tt.wantErr == nil && got != tt.want:
+			t.Errorf("Unmarshal(%s) = %+v, want %+v", tt.in, got, tt.want)
+		}
+	}
+}
+
+// errAny stands for any decoding error in TestUnmarshalJSON
+var errAny = errors.New("any error")
+
+func TestMarshalRoundTrip(t *testing.T) {
+	in := struct {
+		Rate Money `json:"rate"`
+	}{Millimes(45500)}
+	data, err := json.Marshal(in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != `{"rate":{"amount":45500,"currency":"TND"}}` {
+		t.Errorf("Marshal = %s", data)
+	}
+	out := in
+	out.Rate = Money{}
+	if err := json.Unmarshal(data, &out); err != nil || out != in {
+		t.Errorf("Unmarshal(%s) = %+v, %v, want %+v", data, out, err, in)
+	}
+}
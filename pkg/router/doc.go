@@ -0,0 +1,5 @@
+// Package router keeps the app's navigation state: typed routes with their
+// parameters and a back stack that remembers the tab and scroll position of
+// each screen. It does not render anything, the app listens for transitions
+// and shows the matching screen.
+package router
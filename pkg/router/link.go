@@ -0,0 +1,70 @@
+package router
+
+import (
+	"errors"
+	"net/url"
+	"strings"
+)
+
+// Scheme is the custom URL scheme registered for the app
+const Scheme = "skilldar"
+
+// WebHosts are the https hosts whose links open in the app
+var WebHosts = []string{"skilldar.tn", "www.skilldar.tn"}
+
+// ErrUnknownLink is returned for links that do not point to a screen
+var ErrUnknownLink = errors.New("router: unknown link")
+
+// ParseLink turns a deep link into a route. It accepts
+// skilldar://worker/{id}, skilldar://order/{id}, skilldar://chat/{id}
+// and the same paths on the https web hosts.
+func ParseLink(raw string) (Route, error) {
+	u, err := url.Parse(strings.TrimSpace(raw))
+	if err != nil {
+		return Route{}, err
+	}
+
+	var segments []string
+	switch strings.ToLower(u.Scheme) {
+	case Scheme:
+		// skilldar://worker/42 has "worker" as host, skilldar:///worker/42 has none
+		segments = append(segments, u.Host)
+	case "https":
+		if !isWebHost(u.Hostname()) {
+			return Route{}, ErrUnknownLink
+		}
+	default:
+		return Route{}, ErrUnknownLink
+	}
+	for _, s := range strings.Split(u.Path, "/") {
+		if s != "" {
+			segments = append(segments, s)
+		}
+	}
+	if len(segments) > 0 && segments[0] == "" {
+		segments = segments[1:]
+	}
+
+	if len(segments) != 2 {
+		return Route{}, ErrUnknownLink
+	}
+	id := segments[1]
+	switch strings.ToLower(segments[0]) {
+	case "worker":
+		return WorkerProfileRoute(id), nil
+	case "order":
+		return OrderRoute(id), nil
+	case "chat":
+		return ChatRoute(id), nil
+	}
+	return Route{}, ErrUnknownLink
+}
+
+func isWebHost(host string) bool {
+	for _, h := range WebHosts {
+		if strings.EqualFold(host, h) {
+			return true
+		}
+	}
+	return false
+}
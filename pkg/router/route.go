@@ -0,0 +1,143 @@
+package router
+
+import (
+	"net/url"
+	"sort"
+	"strings"
+)
+
+// Name identifies a screen
+type Name string
+
+// Screens of the app
+const (
+	Welcome           Name = "welcome"
+	Login             Name = "login"
+	Main              Name = "main"
+	Profile           Name = "profile"
+	EditProfileClient Name = "edit_profile_client"
+	EditProfileWorker Name = "edit_profile_worker"
+	SavedWorkers      Name = "saved_workers"
+	Addresses         Name = "addresses"
+	Settings          Name = "settings"
+	Availability      Name = "availability"
+	Verification      Name = "verification"
+	Earnings          Name = "earnings"
+	Wallet            Name = "wallet"
+	Referral          Name = "referral"
+	WorkerProfile     Name = "worker_profile"
+	Booking           Name = "booking"
+	Order             Name = "order"
+	Chat              Name = "chat"
+)
+
+// IsRoot reports whether the screen starts a navigation flow,
+// no back navigation is offered from it
+func (n Name) IsRoot() bool {
+	return n == Welcome || n == Login || n == Main
+}
+
+// RequiresAuth reports whether the screen needs a signed-in user.
+// Links to such screens wait until login completes.
+func (n Name) RequiresAuth() bool {
+	switch n {
+	case Welcome, Login, WorkerProfile:
+		return false
+	}
+	return true
+}
+
+// Parameter names
+const (
+	ParamWorkerID = "worker_id"
+	ParamOrderID  = "order_id"
+	ParamChatID   = "chat_id"
+)
+
+// Params are the parameters of a route, e.g. the worker to show
+type Params map[string]string
+
+// Route is a screen with its parameters
+type Route struct {
+	Name   Name
+	Params Params
+}
+
+// To returns a route to a screen without parameters
+func To(name Name) Route {
+	return Route{Name: name}
+}
+
+// WorkerProfileRoute shows a worker's public profile
+func WorkerProfileRoute(workerID string) Route {
+	return Route{Name: WorkerProfile, Params: Params{ParamWorkerID: workerID}}
+}
+
+// BookingRoute shows the booking form for a worker
+func BookingRoute(workerID string) Route {
+	return Route{Name: Booking, Params: Params{ParamWorkerID: workerID}}
+}
+
+// OrderRoute shows an order's details
+func OrderRoute(orderID string) Route {
+	return Route{Name: Order, Params: Params{ParamOrderID: orderID}}
+}
+
+// ChatRoute shows a conversation
+func ChatRoute(chatID string) Route {
+	return Route{Name: Chat, Params: Params{ParamChatID: chatID}}
+}
+
+// WorkerID returns the worker parameter, empty if the route has none
+func (r Route) WorkerID() string {
+	return r.Params[ParamWorkerID]
+}
+
+// OrderID returns the order parameter, empty if the route has none
+func (r Route) OrderID() string {
+	return r.Params[ParamOrderID]
+}
+
+// ChatID returns the chat parameter, empty if the route has none
+func (r Route) ChatID() string {
+	return r.Params[ParamChatID]
+}
+
+// Equal reports whether two routes show the same screen with the same parameters
+func (r Route) Equal(other Route) bool {
+	if r.Name != other.Name || len(r.Params) != len(other.Params) {
+		return false
+	}
+	for k, v := range r.Params {
+		if other.Params[k] != v {
+			return false
+		}
+	}
+	return true
+}
+
+// String formats the route like "worker_profile?worker_id=w-001"
+func (r Route) String() string {
+	if len(r.Params) == 0 {
+		return string(r.Name)
+	}
+	keys := make([]string, 0, len(r.Params))
+	for k := range r.Params {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var b strings.Builder
+	b.WriteString(string(r.Name))
+	for i, k := range keys {
+		if i == 0 {
+			b.WriteByte('?')
+		} else {
+			b.WriteByte('&')
+		}
+		b.WriteString(url.QueryEscape(k))
+		b.WriteByte('=')
+		b.WriteString(url.QueryEscape(r.Params[k]))
+	}
+	return b.String()
+}
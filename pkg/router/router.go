@@ -0,0 +1,144 @@
+package router
+
+// ViewState is what a screen looks like when the user leaves it,
+// restored when they come back
+type ViewState struct {
+	Tab     int     // Selected tab for screens with tabs
+	ScrollX float32 // Scroll offset of the screen's main scroll container
+	ScrollY float32
+}
+
+// Entry is a route on the back stack
+type Entry struct {
+	Route Route
+	View  ViewState
+}
+
+// Action is the kind of navigation that caused a transition
+type Action int
+
+const (
+	Push    Action = iota // A new screen on top of the stack
+	Pop                   // Back to the previous screen
+	Replace               // The current screen swapped for another
+	Reset                 // The stack cleared and restarted
+)
+
+func (a Action) String() string {
+	switch a {
+	case Push:
+		return "push"
+	case Pop:
+		return "pop"
+	case Replace:
+		return "replace"
+	case Reset:
+		return "reset"
+	}
+	return "unknown"
+}
+
+// Transition describes a navigation, To is the entry now on top.
+// When going back, To.View holds the state to restore.
+type Transition struct {
+	Action Action
+	From   Route // Zero if the stack was empty
+	To     Entry
+}
+
+// Router holds the back stack. It is not safe for concurrent use,
+// navigate from the UI goroutine.
+type Router struct {
+	stack     []Entry
+	listeners []func(Transition)
+}
+
+// New creates a router with an empty stack
+func New() *Router {
+	return &Router{}
+}
+
+// OnChange registers a function called after every navigation
+func (r *Router) OnChange(listener func(Transition)) {
+	r.listeners = append(r.listeners, listener)
+}
+
+// Current returns the entry on top of the stack, ok is false if the stack is empty
+func (r *Router) Current() (Entry, bool) {
+	if len(r.stack) == 0 {
+		return Entry{}, false
+	}
+	return r.stack[len(r.stack)-1], true
+}
+
+// Stack returns the routes on the stack, bottom first
+func (r *Router) Stack() []Route {
+	routes := make([]Route, len(r.stack))
+	for i, e := range r.stack {
+		routes[i] = e.Route
+	}
+	return routes
+}
+
+// CanGoBack reports whether there is a previous screen to go back to.
+// Root screens such as main never go back.
+func (r *Router) CanGoBack() bool {
+	current, ok := r.Current()
+	return ok && len(r.stack) > 1 && !current.Route.Name.IsRoot()
+}
+
+// SaveViewState records the state of the current screen before leaving it
+func (r *Router) SaveViewState(view ViewState) {
+	if len(r.stack) > 0 {
+		r.stack[len(r.stack)-1].View = view
+	}
+}
+
+// Push shows a route on top of the current one.
+// Pushing the route already shown does nothing.
+func (r *Router) Push(route Route) {
+	current, ok := r.Current()
+	if ok && current.Route.Equal(route) {
+		return
+	}
+	r.stack = append(r.stack, Entry{Route: route})
+	r.notify(Push, current.Route)
+}
+
+// Replace swaps the current route for another, which is useful for
+// redirects that should not be returned to
+func (r *Router) Replace(route Route) {
+	current, ok := r.Current()
+	if !ok {
+		r.Push(route)
+		return
+	}
+	r.stack[len(r.stack)-1] = Entry{Route: route}
+	r.notify(Replace, current.Route)
+}
+
+// Reset clears the stack and starts again from a route, e.g. after login
+func (r *Router) Reset(route Route) {
+	current, _ := r.Current()
+	r.stack = []Entry{{Route: route}}
+	r.notify(Reset, current.Route)
+}
+
+// Pop goes back to the previous screen and returns false if there is none
+func (r *Router) Pop() bool {
+	if !r.CanGoBack() {
+		return false
+	}
+	current, _ := r.Current()
+	r.stack = r.stack[:len(r.stack)-1]
+	r.notify(Pop, current.Route)
+	return true
+}
+
+func (r *Router) notify(action Action, from Route) {
+	to, _ := r.Current()
+	t := Transition{Action: action, From: from, To: to}
+	for _, listener := range r.listeners {
+		listener(t)
+	}
+}
@@ -0,0 +1,251 @@
+package router
+
+import "testing"
+
+func names(routes []Route) []string {
+	out := make([]string, len(routes))
+	for i, r := range routes {
+		out[i] = r.String()
+	}
+	return out
+}
+
+func equal(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func TestRouteString(t *testing.T) {
+	tests := []struct {
+		route Route
+		want  string
+	}{
+		{To(Main), "main"},
+		{WorkerProfileRoute("w-001"), "worker_profile?worker_id=w-001"},
+		{BookingRoute("a b"), "booking?worker_id=a+b"},
+		{Route{Name: "x", Params: Params{"b": "2", "a": "1"}}, "x?a=1&b=2"},
+	}
+	for _, tt := range tests {
+		if got := tt.route.String(); got != tt.want {
+			t.Errorf("String() = %q, want %q", got, tt.want)
+		}
+	}
+}
+
+func TestRouteEqual(t *testing.T) {
+	tests := []struct {
+		a, b Route
+		want bool
+	}{
+		{To(Main), To(Main), true},
+		{To(Main), To(Profile), false},
+		{WorkerProfileRoute("w-1"), WorkerProfileRoute("w-1"), true},
+		{WorkerProfileRoute("w-1"), WorkerProfileRoute("w-2"), false},
+		{WorkerProfileRoute("w-1"), BookingRoute("w-1"), false},
+		{To(WorkerProfile), WorkerProfileRoute("w-1"), false},
+		{Route{Name: Main, Params: Params{}}, To(Main), true},
+	}
+	for _, tt := range tests {
+		if got := tt.a.Equal(tt.b); got != tt.want {
+			t.Errorf("%v.Equal(%v) = %v, want %v", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestNavigation(t *testing.T) {
+	type step struct {
+		do        func(r *Router) bool
+		wantStack []string
+		wantBack  bool
+	}
+	push := func(route Route) func(*Router) bool {
+		return func(r *Router) bool { r.Push(route); return true }
+	}
+	replace := func(route Route) func(*Router) bool {
+		return func(r *Router) bool { r.Replace(route); return true }
+	}
+	reset := func(route Route) func(*Router) bool {
+		return func(r *Router) bool { r.Reset(route); return true }
+	}
+	pop := func(r *Router) bool { return r.Pop() }
+
+	tests := []struct {
+		name  string
+		steps []step
+	}{
+		{"login flow has no back", []step{
+			{push(To(Welcome)), []string{"welcome"}, false},
+			{push(To(Login)), []string{"welcome", "login"}, false},
+			{reset(To(Main)), []string{"main"}, false},
+		}},
+		{"push and pop", []step{
+			{reset(To(Main)), []string{"main"}, false},
+			{push(WorkerProfileRoute("w-1")), []string{"main", "worker_profile?worker_id=w-1"}, true},
+			{push(BookingRoute("w-1")), []string{"main", "worker_profile?worker_id=w-1", "booking?worker_id=w-1"}, true},
+			{pop, []string{"main", "worker_profile?worker_id=w-1"}, true},
+			{pop, []string{"main"}, false},
+		}},
+		{"pop at root fails", []step{
+			{reset(To(Main)), []string{"main"}, false},
+			{func(r *Router) bool { return !r.Pop() }, []string{"main"}, false},
+		}},
+		{"pushing the current route is ignored", []step{
+			{reset(To(Main)), []string{"main"}, false},
+			{push(To(Wallet)), []string{"main", "wallet"}, true},
+			{push(To(Wallet)), []string{"main", "wallet"}, true},
+			{push(WorkerProfileRoute("w-1")), []string{"main", "wallet", "worker_profile?worker_id=w-1"}, true},
+			{push(WorkerProfileRoute("w-2")), []string{"main", "wallet", "worker_profile?worker_id=w-1", "worker_profile?worker_id=w-2"}, true},
+		}},
+		{"replace keeps depth", []step{
+			{reset(To(Main)), []string{"main"}, false},
+			{push(BookingRoute("w-1")), []string{"main", "booking?worker_id=w-1"}, true},
+			{replace(To(Wallet)), []string{"main", "wallet"}, true},
+			{pop, []string{"main"}, false},
+		}},
+		{"replace on empty stack pushes", []step{
+			{replace(To(Welcome)), []string{"welcome"}, false},
+		}},
+		{"root in the middle of the stack", []step{
+			{push(To(Welcome)), []string{"welcome"}, false},
+			{push(To(Main)), []string{"welcome", "main"}, false},
+			{push(To(Profile)), []string{"welcome", "main", "profile"}, true},
+			{pop, []string{"welcome", "main"}, false},
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := New()
+			for i, s := range tt.steps {
+				if !s.do(r) {
+					t.Fatalf("step %d failed", i)
+				}
+				if got := names(r.Stack()); !equal(got, s.wantStack) {
+					t.Fatalf("step %d: stack = %v, want %v", i, got, s.wantStack)
+				}
+				if got := r.CanGoBack(); got != s.wantBack {
+					t.Fatalf("step %d: CanGoBack = %v, want %v", i, got, s.wantBack)
+				}
+			}
+		})
+	}
+}
+
+func TestViewStateRestoredOnPop(t *testing.T) {
+	r := New()
+	r.Reset(To(Main))
+	r.SaveViewState(ViewState{Tab: 1, ScrollY: 420})
+	r.Push(To(Profile))
+	r.SaveViewState(ViewState{ScrollY: 80})
+	r.Push(WorkerProfileRoute("w-1"))
+
+	var transitions []Transition
+	r.OnChange(func(t Transition) { transitions = append(transitions, t) })
+
+	r.Pop()
+	r.Pop()
+
+	if len(transitions) != 2 {
+		t.Fatalf("got %d transitions, want 2", len(transitions))
+	}
+	if got := transitions[0].To.View; got != (ViewState{ScrollY: 80}) {
+		t.Errorf("profile view = %+v, want scroll 80", got)
+	}
+	if got := transitions[1].To.View; got != (ViewState{Tab: 1, ScrollY: 420}) {
+		t.Errorf("main view = %+v, want tab 1 scroll 420", got)
+	}
+	if transitions[1].Action != Pop || !transitions[1].From.Equal(To(Profile)) {
+		t.Errorf("transition = %v from %v, want pop from profile", transitions[1].Action, transitions[1].From)
+	}
+}
+
+func TestPushStartsWithFreshViewState(t *testing.T) {
+	r := New()
+	r.Reset(To(Main))
+	r.Push(To(Wallet))
+	r.SaveViewState(ViewState{ScrollY: 300})
+	r.Pop()
+
+	var last Transition
+	r.OnChange(func(t Transition) { last = t })
+	r.Push(To(Wallet))
+
+	if last.Action != Push || last.To.View != (ViewState{}) {
+		t.Errorf("transition = %v with view %+v, want push with empty view", last.Action, last.To.View)
+	}
+}
+
+func TestNoTransitionWhenNothingChanges(t *testing.T) {
+	r := New()
+	r.Reset(To(Main))
+
+	calls := 0
+	r.OnChange(func(Transition) { calls++ })
+	r.Push(To(Main))
+	r.Pop()
+
+	if calls != 0 {
+		t.Errorf("got %d transitions, want none", calls)
+	}
+}
+
+func TestParseLink(t *testing.T) {
+	tests := []struct {
+		link    string
+		want    Route
+		wantErr bool
+	}{
+		{"skilldar://worker/w-001", WorkerProfileRoute("w-001"), false},
+		{"skilldar:///worker/w-001", WorkerProfileRoute("w-001"), false},
+		{"skilldar://order/o-42", OrderRoute("o-42"), false},
+		{"skilldar://chat/c-7/", ChatRoute("c-7"), false},
+		{"SKILLDAR://Worker/w-001", WorkerProfileRoute("w-001"), false},
+		{"skilldar://worker/a%20b", WorkerProfileRoute("a b"), false},
+		{"https://skilldar.tn/worker/w-001", WorkerProfileRoute("w-001"), false},
+		{"https://www.skilldar.tn/order/o-42?utm_source=sms", OrderRoute("o-42"), false},
+		{"  https://skilldar.tn/chat/c-7  ", ChatRoute("c-7"), false},
+		{"http://skilldar.tn/worker/w-001", Route{}, true},
+		{"https://evil.example/worker/w-001", Route{}, true},
+		{"skilldar://worker", Route{}, true},
+		{"skilldar://worker/w-1/extra", Route{}, true},
+		{"skilldar://payment/return", Route{}, true},
+		{"skilldar://wallet/x", Route{}, true},
+		{"not a link", Route{}, true},
+	}
+	for _, tt := range tests {
+		got, err := ParseLink(tt.link)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ParseLink(%q) error = %v, wantErr %v", tt.link, err, tt.wantErr)
+			continue
+		}
+		if !got.Equal(tt.want) {
+			t.Errorf("ParseLink(%q) = %v, want %v", tt.link, got, tt.want)
+		}
+	}
+}
+
+func TestRequiresAuth(t *testing.T) {
+	tests := []struct {
+		name Name
+		want bool
+	}{
+		{Welcome, false},
+		{Login, false},
+		{WorkerProfile, false},
+		{Order, true},
+		{Chat, true},
+		{Main, true},
+	}
+	for _, tt := range tests {
+		if got := tt.name.RequiresAuth(); got != tt.want {
+			t.Errorf("%s.RequiresAuth() = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
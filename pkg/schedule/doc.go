@@ -0,0 +1,4 @@
+// Package schedule computes the bookable time slots of a worker from weekly
+// working hours, days off and buffer time between jobs.
+// All wall-clock values are interpreted in the Africa/Tunis time zone.
+package schedule
@@ -0,0 +1,205 @@
+package schedule
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+)
+
+// TimeZone is the IANA name of the zone used for all schedules
+const TimeZone = "Africa/Tunis"
+
+// DateLayout is the format used for days off
+const DateLayout = "2006-01-02"
+
+var tunis = loadLocation()
+
+func loadLocation() *time.Location {
+	loc, err := time.LoadLocation(TimeZone)
+	if err != nil {
+		// Tunisia has used UTC+1 without daylight saving since 2009
+		return time.FixedZone("CET", 60*60)
+	}
+	return loc
+}
+
+// Location returns the time zone used for schedules
+func Location() *time.Location {
+	return tunis
+}
+
+// Clock is a time of day expressed in minutes since midnight
+type Clock int
+
+// NewClock creates a clock value from hours and minutes
+func NewClock(hour, minute int) Clock {
+	return Clock(hour*60 + minute)
+}
+
+// ParseClock parses a "15:04" formatted time of day
+func ParseClock(s string) (Clock, error) {
+	var hour, minute int
+	if _, err := fmt.Sscanf(s, "%d:%d", &hour, &minute); err != nil {
+		return 0, fmt.Errorf("invalid time of day %q", s)
+	}
+	if hour < 0 || hour > 24 || minute < 0 || minute > 59 || (hour == 24 && minute != 0) {
+		return 0, fmt.Errorf("invalid time of day %q", s)
+	}
+	return NewClock(hour, minute), nil
+}
+
+// Hour returns the hour part of the clock
+func (c Clock) Hour() int {
+	return int(c) / 60
+}
+
+// Minute returns the minute part of the clock
+func (c Clock) Minute() int {
+	return int(c) % 60
+}
+
+// String formats the clock as "15:04"
+func (c Clock) String() string {
+	return fmt.Sprintf("%02d:%02d", c.Hour(), c.Minute())
+}
+
+// Range is a working period within a day, End is exclusive
+type Range struct {
+	Start Clock `json:"start"`
+	End   Clock `json:"end"`
+}
+
+// Booking is an existing job that occupies the worker's time
+type Booking struct {
+	Start time.Time `json:"start"`
+	End   time.Time `json:"end"`
+}
+
+// Slot is a bookable interval
+type Slot struct {
+	Start time.Time
+	End   time.Time
+}
+
+// Schedule describes when a worker can be booked
+type Schedule struct {
+	Weekly        map[time.Weekday][]Range `json:"weekly"`         // Working hours per weekday
+	DaysOff       []string                 `json:"days_off"`       // Dates in DateLayout
+	BufferMinutes int                      `json:"buffer_minutes"` // Free time kept before and after each job
+}
+
+// Validate checks that working hours are well-formed and do not overlap
+func (s Schedule) Validate() error {
+	if s.BufferMinutes < 0 {
+		return errors.New("buffer time cannot be negative")
+	}
+	for day, ranges := range s.Weekly {
+		sorted := append([]Range(nil), ranges...)
+		sort.Slice(sorted, func(i, j int) bool { return sorted[i].Start < sorted[j].Start })
+		for i, r := range sorted {
+			if r.Start < 0 || r.End > NewClock(24, 0) || r.Start >= r.End {
+				return fmt.Errorf("%s: invalid working hours %s-%s", day, r.Start, r.End)
+			}
+			if i > 0 && r.Start < sorted[i-1].End {
+				return fmt.Errorf("%s: working hours overlap", day)
+			}
+		}
+	}
+	for _, d := range s.DaysOff {
+		if _, err := time.ParseInLocation(DateLayout, d, tunis); err != nil {
+			return fmt.Errorf("invalid day off %q", d)
+		}
+	}
+	return nil
+}
+
+// IsDayOff reports whether t falls on one of the worker's days off
+func (s Schedule) IsDayOff(t time.Time) bool {
+	key := t.In(tunis).Format(DateLayout)
+	for _, d := range s.DaysOff {
+		if d == key {
+			return true
+		}
+	}
+	return false
+}
+
+// Slots returns the bookable slots of the given length starting within [from, to).
+// Slot starts are aligned on step from the beginning of each working range, in
+// Africa/Tunis wall-clock time. Slots overlapping a booking, including the buffer
+// on both sides of it, are left out.
+func (s Schedule) Slots(from, to time.Time, length, step time.Duration, bookings []Booking) []Slot {
+	if length <= 0 || step <= 0 || !from.Before(to) {
+		return nil
+	}
+	stepMinutes := int(step / time.Minute)
+	if stepMinutes == 0 {
+		stepMinutes = 1
+	}
+
+	var slots []Slot
+	first := from.In(tunis)
+	day := time.Date(first.Year(), first.Month(), first.Day(), 0, 0, 0, 0, tunis)
+	for ; day.Before(to); day = day.AddDate(0, 0, 1) {
+		if s.IsDayOff(day) {
+			continue
+		}
+		for _, r := range s.Weekly[day.Weekday()] {
+			rangeEnd := wallTime(day, r.End)
+			for c := r.Start; c < r.End; c += Clock(stepMinutes) {
+				start := wallTime(day, c)
+				if start.In(tunis).Hour() != c.Hour() || start.In(tunis).Minute() != c.Minute() {
+					// Skipped by a daylight saving transition
+					continue
+				}
+				end := start.Add(length)
+				if end.After(rangeEnd) {
+					break
+				}
+				if start.Before(from) || !start.Before(to) {
+					continue
+				}
+				if s.conflicts(start, end, bookings) {
+					continue
+				}
+				slots = append(slots, Slot{Start: start, End: end})
+			}
+		}
+	}
+
+	sort.Slice(slots, func(i, j int) bool { return slots[i].Start.Before(slots[j].Start) })
+	return slots
+}
+
+// IsAvailable reports whether a job of the given length can start at t
+func (s Schedule) IsAvailable(t time.Time, length time.Duration, bookings []Booking) bool {
+	local := t.In(tunis)
+	if s.IsDayOff(local) {
+		return false
+	}
+	day := time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, tunis)
+	for _, r := range s.Weekly[local.Weekday()] {
+		start, end := wallTime(day, r.Start), wallTime(day, r.End)
+		if !t.Before(start) && !t.Add(length).After(end) {
+			return !s.conflicts(t, t.Add(length), bookings)
+		}
+	}
+	return false
+}
+
+// conflicts reports whether [start, end) overlaps a booking widened by the buffer
+func (s Schedule) conflicts(start, end time.Time, bookings []Booking) bool {
+	buffer := time.Duration(s.BufferMinutes) * time.Minute
+	for _, b := range bookings {
+		if start.Before(b.End.Add(buffer)) && end.After(b.Start.Add(-buffer)) {
+			return true
+		}
+	}
+	return false
+}
+
+// wallTime returns the instant at the given wall clock on day
+func wallTime(day time.Time, c Clock) time.Time {
+	return time.Date(day.Year(), day.Month(), day.Day(), c.Hour(), c.Minute(), 0, 0, tunis)
+}
@@ -0,0 +1,275 @@
+package schedule
+
+import (
+	"testing"
+	"time"
+	_ "time/tzdata" // Make Africa/Tunis history available regardless of the host
+)
+
+func at(year int, month time.Month, day, hour, minute int) time.Time {
+	return time.Date(year, month, day, hour, minute, 0, 0, Location())
+}
+
+func weekdays(r ...Range) map[time.Weekday][]Range {
+	weekly := make(map[time.Weekday][]Range)
+	for d := time.Monday; d <= time.Friday; d++ {
+		weekly[d] = r
+	}
+	return weekly
+}
+
+func starts(slots []Slot) []string {
+	out := make([]string, len(slots))
+	for i, s := range slots {
+		out[i] = s.Start.In(Location()).Format("01-02 15:04")
+	}
+	return out
+}
+
+func equal(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func TestParseClock(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    Clock
+		wantErr bool
+	}{
+		{"08:00", NewClock(8, 0), false},
+		{"17:30", NewClock(17, 30), false},
+		{"24:00", NewClock(24, 0), false},
+		{"24:30", 0, true},
+		{"25:00", 0, true},
+		{"9h", 0, true},
+	}
+	for _, tt := range tests {
+		got, err := ParseClock(tt.in)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ParseClock(%q) error = %v, wantErr %v", tt.in, err, tt.wantErr)
+			continue
+		}
+		if !tt.wantErr && got != tt.want {
+			t.Errorf("ParseClock(%q) = %v, want %v", tt.in, got, tt.want)
+		}
+		if !tt.wantErr && got.String() != tt.in {
+			t.Errorf("Clock.String() = %q, want %q", got.String(), tt.in)
+		}
+	}
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		s       Schedule
+		wantErr bool
+	}{
+		{"empty", Schedule{}, false},
+		{"regular week", Schedule{Weekly: weekdays(Range{NewClock(8, 0), NewClock(17, 0)})}, false},
+		{"split shift", Schedule{Weekly: map[time.Weekday][]Range{
+			time.Monday: {{NewClock(14, 0), NewClock(18, 0)}, {NewClock(8, 0), NewClock(12, 0)}},
+		}}, false},
+		{"end before start", Schedule{Weekly: map[time.Weekday][]Range{
+			time.Monday: {{NewClock(17, 0), NewClock(8, 0)}},
+		}}, true},
+		{"overlapping ranges", Schedule{Weekly: map[time.Weekday][]Range{
+			time.Monday: {{NewClock(8, 0), NewClock(13, 0)}, {NewClock(12, 0), NewClock(18, 0)}},
+		}}, true},
+		{"negative buffer", Schedule{BufferMinutes: -15}, true},
+		{"bad day off", Schedule{DaysOff: []string{"2025-13-01"}}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.s.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestSlots(t *testing.T) {
+	morning := Range{NewClock(8, 0), NewClock(12, 0)}
+
+	tests := []struct {
+		name     string
+		s        Schedule
+		from, to time.Time
+		length   time.Duration
+		step     time.Duration
+		bookings []Booking
+		want     []string
+	}{
+		{
+			name:   "hourly slots in a morning",
+			s:      Schedule{Weekly: weekdays(morning)},
+			from:   at(2025, time.June, 2, 0, 0), // Monday
+			to:     at(2025, time.June, 3, 0, 0),
+			length: time.Hour, step: time.Hour,
+			want: []string{"06-02 08:00", "06-02 09:00", "06-02 10:00", "06-02 11:00"},
+		},
+		{
+			name:   "slot must fit before the end of the range",
+			s:      Schedule{Weekly: weekdays(morning)},
+			from:   at(2025, time.June, 2, 0, 0),
+			to:     at(2025, time.June, 3, 0, 0),
+			length: 2 * time.Hour, step: time.Hour,
+			want: []string{"06-02 08:00", "06-02 09:00", "06-02 10:00"},
+		},
+		{
+			name:   "weekend has no working hours",
+			s:      Schedule{Weekly: weekdays(morning)},
+			from:   at(2025, time.June, 7, 0, 0), // Saturday
+			to:     at(2025, time.June, 9, 0, 0),
+			length: time.Hour, step: time.Hour,
+			want: []string{},
+		},
+		{
+			name:   "day off is skipped",
+			s:      Schedule{Weekly: weekdays(morning), DaysOff: []string{"2025-06-02"}},
+			from:   at(2025, time.June, 2, 0, 0),
+			to:     at(2025, time.June, 4, 0, 0),
+			length: 2 * time.Hour, step: 2 * time.Hour,
+			want: []string{"06-03 08:00", "06-03 10:00"},
+		},
+		{
+			name:   "past slots are not offered",
+			s:      Schedule{Weekly: weekdays(morning)},
+			from:   at(2025, time.June, 2, 9, 30),
+			to:     at(2025, time.June, 3, 0, 0),
+			length: time.Hour, step: time.Hour,
+			want: []string{"06-02 10:00", "06-02 11:00"},
+		},
+		{
+			name:     "booking blocks overlapping slots",
+			s:        Schedule{Weekly: weekdays(morning)},
+			from:     at(2025, time.June, 2, 0, 0),
+			to:       at(2025, time.June, 3, 0, 0),
+			length:   time.Hour,
+			step:     time.Hour,
+			bookings: []Booking{{at(2025, time.June, 2, 9, 0), at(2025, time.June, 2, 10, 0)}},
+			want:     []string{"06-02 08:00", "06-02 10:00", "06-02 11:00"},
+		},
+		{
+			name:     "buffer keeps free time around bookings",
+			s:        Schedule{Weekly: weekdays(morning), BufferMinutes: 30},
+			from:     at(2025, time.June, 2, 0, 0),
+			to:       at(2025, time.June, 3, 0, 0),
+			length:   time.Hour,
+			step:     30 * time.Minute,
+			bookings: []Booking{{at(2025, time.June, 2, 9, 30), at(2025, time.June, 2, 10, 0)}},
+			want:     []string{"06-02 08:00", "06-02 10:30", "06-02 11:00"},
+		},
+		{
+			name: "split shift",
+			s: Schedule{Weekly: map[time.Weekday][]Range{
+				time.Monday: {{NewClock(14, 0), NewClock(16, 0)}, {NewClock(8, 0), NewClock(10, 0)}},
+			}},
+			from:   at(2025, time.June, 2, 0, 0),
+			to:     at(2025, time.June, 3, 0, 0),
+			length: time.Hour, step: time.Hour,
+			want: []string{"06-02 08:00", "06-02 09:00", "06-02 14:00", "06-02 15:00"},
+		},
+		{
+			name:   "range ending at midnight",
+			s:      Schedule{Weekly: map[time.Weekday][]Range{time.Monday: {{NewClock(22, 0), NewClock(24, 0)}}}},
+			from:   at(2025, time.June, 2, 0, 0),
+			to:     at(2025, time.June, 3, 0, 0),
+			length: time.Hour, step: time.Hour,
+			want: []string{"06-02 22:00", "06-02 23:00"},
+		},
+		{
+			name:   "from given in UTC is interpreted in Tunis",
+			s:      Schedule{Weekly: weekdays(morning)},
+			from:   time.Date(2025, time.June, 2, 8, 0, 0, 0, time.UTC), // 09:00 in Tunis
+			to:     time.Date(2025, time.June, 2, 23, 0, 0, 0, time.UTC),
+			length: time.Hour, step: time.Hour,
+			want: []string{"06-02 09:00", "06-02 10:00", "06-02 11:00"},
+		},
+		{
+			// Tunisia observed daylight saving time until 2008:
+			// on 30 March 2008 clocks jumped from 02:00 to 03:00.
+			name:   "daylight saving gap is skipped",
+			s:      Schedule{Weekly: map[time.Weekday][]Range{time.Sunday: {{NewClock(1, 0), NewClock(5, 0)}}}},
+			from:   at(2008, time.March, 30, 0, 0),
+			to:     at(2008, time.March, 31, 0, 0),
+			length: time.Hour, step: time.Hour,
+			want: []string{"03-30 01:00", "03-30 03:00", "03-30 04:00"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := starts(tt.s.Slots(tt.from, tt.to, tt.length, tt.step, tt.bookings))
+			if !equal(got, tt.want) {
+				t.Errorf("Slots() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSlotsInvalidArguments(t *testing.T) {
+	s := Schedule{Weekly: weekdays(Range{NewClock(8, 0), NewClock(12, 0)})}
+	from, to := at(2025, time.June, 2, 0, 0), at(2025, time.June, 3, 0, 0)
+
+	if got := s.Slots(from, to, 0, time.Hour, nil); got != nil {
+		t.Errorf("zero length: got %v, want nil", got)
+	}
+	if got := s.Slots(from, to, time.Hour, 0, nil); got != nil {
+		t.Errorf("zero step: got %v, want nil", got)
+	}
+	if got := s.Slots(to, from, time.Hour, time.Hour, nil); got != nil {
+		t.Errorf("reversed range: got %v, want nil", got)
+	}
+}
+
+func TestIsAvailable(t *testing.T) {
+	s := Schedule{
+		Weekly:        weekdays(Range{NewClock(8, 0), NewClock(17, 0)}),
+		DaysOff:       []string{"2025-06-04"},
+		BufferMinutes: 15,
+	}
+	bookings := []Booking{{at(2025, time.June, 2, 13, 0), at(2025, time.June, 2, 15, 0)}}
+
+	tests := []struct {
+		name string
+		at   time.Time
+		want bool
+	}{
+		{"within working hours", at(2025, time.June, 2, 9, 0), true},
+		{"before opening", at(2025, time.June, 2, 7, 30), false},
+		{"job would run past closing", at(2025, time.June, 2, 16, 30), false},
+		{"during a booking", at(2025, time.June, 2, 14, 0), false},
+		{"inside the buffer before a booking", at(2025, time.June, 2, 11, 50), false},
+		{"inside the buffer after a booking", at(2025, time.June, 2, 15, 10), false},
+		{"after the buffer", at(2025, time.June, 2, 15, 15), true},
+		{"day off", at(2025, time.June, 4, 9, 0), false},
+		{"weekend", at(2025, time.June, 7, 9, 0), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := s.IsAvailable(tt.at, time.Hour, bookings); got != tt.want {
+				t.Errorf("IsAvailable(%v) = %v, want %v", tt.at, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsDayOff(t *testing.T) {
+	s := Schedule{DaysOff: []string{"2025-06-04"}}
+
+	// 23:30 UTC on the 3rd is already the 4th in Tunis
+	if !s.IsDayOff(time.Date(2025, time.June, 3, 23, 30, 0, 0, time.UTC)) {
+		t.Error("expected 2025-06-03 23:30 UTC to be a day off in Tunis")
+	}
+	if s.IsDayOff(time.Date(2025, time.June, 3, 22, 30, 0, 0, time.UTC)) {
+		t.Error("expected 2025-06-03 22:30 UTC not to be a day off in Tunis")
+	}
+}
@@ -0,0 +1,46 @@
+package search
+
+// Default holds the app categories with their trades in English, French,
+// Arabic and Latin-script Derja. Category names are as stored by the API.
+var Default = NewDictionary(
+	Category{"Plumbing", []string{
+		"Plumber", "plombier", "plomberie", "tuyauterie",
+		"سباك", "سباكة", "بلومبي", "sabbek", "sabbak", "bloumbi",
+	}},
+	Category{"Electricity", []string{
+		"Electrician", "électricien", "électricité",
+		"كهربائي", "كهرباء", "تريسيان", "ضو", "trisien", "triciti", "kahraba", "dhaw",
+	}},
+	Category{"Painting", []string{
+		"Painter", "peintre", "peinture",
+		"دهان", "صباغ", "بياض", "dahhen", "sabbegh", "bayyadh",
+	}},
+	Category{"AC Fixing", []string{
+		"AC Technician", "air conditioning", "climatisation", "climatiseur", "clim", "frigoriste",
+		"مكيف", "كليماتيزور", "mkayef", "klimatizor",
+	}},
+	Category{"Home Cleaning", []string{
+		"Cleaner", "ménage", "femme de ménage", "nettoyage",
+		"تنظيف", "منظف", "نظافة", "tandhif", "nadhafa",
+	}},
+	Category{"Small Repairs", []string{
+		"Handyman", "bricolage", "bricoleur", "réparation",
+		"تصليح", "صيانة", "بريكولاج", "tasli7", "brikolaj",
+	}},
+	Category{"Furniture Assembly", []string{
+		"Carpenter", "menuisier", "montage de meubles", "meubles",
+		"نجار", "أثاث", "موبيليا", "najjar", "mobilia",
+	}},
+	Category{"Water Leakage", []string{
+		"Leak", "fuite d'eau", "fuite", "étanchéité",
+		"تسرب", "تسرب الماء", "ماء",
+	}},
+	Category{"Appliance Repair", []string{
+		"Appliance", "électroménager", "machine à laver", "frigo",
+		"كهرومنزلي", "فريجيدار", "ماكينة", "frijidar", "makina",
+	}},
+	Category{"Locksmiths", []string{
+		"Locksmith", "serrurier", "serrure", "clé",
+		"قفال", "سروري", "مفتاح", "sarrouri", "mefte7",
+	}},
+)
@@ -0,0 +1,12 @@
+// Package search matches what clients type against worker names, skills and
+// categories across the ways Tunisians write them: French ("plombier"),
+// Arabic script ("سباك") and Derja in Latin letters ("sabbek", "9ahwa").
+//
+// Text is folded before comparing: case, French accents, Arabic diacritics
+// and letter variants are removed, see Fold. Words written in different
+// scripts are compared by their consonant skeleton, see Skeleton, and typos
+// are forgiven by edit distance, see Distance.
+//
+// Category synonyms live in a Dictionary. Default holds the app categories
+// in English, French, Arabic and Derja.
+package search
@@ -0,0 +1,165 @@
+package search
+
+import (
+	"strings"
+	"unicode"
+)
+
+// latinFolds removes French accents and ligatures
+var latinFolds = map[rune]string{
+	'à': "a", 'á': "a", 'â': "a", 'ã': "a", 'ä': "a", 'å': "a",
+	'ç': "c",
+	'è': "e", 'é': "e", 'ê': "e", 'ë': "e",
+	'ì': "i", 'í': "i", 'î': "i", 'ï': "i",
+	'ñ': "n",
+	'ò': "o", 'ó': "o", 'ô': "o", 'õ': "o", 'ö': "o",
+	'ù': "u", 'ú': "u", 'û': "u", 'ü': "u",
+	'ý': "y", 'ÿ': "y",
+	'œ': "oe", 'æ': "ae", 'ß': "ss",
+}
+
+// arabicFolds merges the letter variants people use interchangeably
+var arabicFolds = map[rune]string{
+	'أ': "ا", 'إ': "ا", 'آ': "ا", 'ٱ': "ا",
+	'ى': "ي", 'ئ': "ي",
+	'ؤ': "و",
+	'ة': "ه",
+}
+
+// isArabicMark reports whether r is a short vowel, another diacritic or the
+// tatweel used to stretch words, which are all left out when comparing
+func isArabicMark(r rune) bool {
+	return r >= 0x064B && r <= 0x065F || r == 0x0670 || r == 0x0640
+}
+
+// Fold lowercases text and removes what people leave out or write in
+// different ways: French accents, Arabic diacritics and letter variants.
+// Arabic-Indic digits become ASCII digits and everything that is not a
+// letter or a digit becomes a space, so "Électricité" and "electricite"
+// fold to the same text, as do "سَبّاك" and "سباك".
+func Fold(text string) string {
+	var b strings.Builder
+	for _, r := range text {
+		r = unicode.ToLower(r)
+		switch {
+		case isArabicMark(r):
+		case latinFolds[r] != "":
+			b.WriteString(latinFolds[r])
+		case arabicFolds[r] != "":
+			b.WriteString(arabicFolds[r])
+		case r >= '٠' && r <= '٩':
+			b.WriteRune('0' + r - '٠')
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			b.WriteRune(r)
+		default:
+			b.WriteRune(' ')
+		}
+	}
+	return strings.Join(strings.Fields(b.String()), " ")
+}
+
+// Words splits text into folded words
+func Words(text string) []string {
+	return strings.Fields(Fold(text))
+}
+
+// arabicSounds spells Arabic letters in Latin consonants. Long vowels, the
+// hamza and ain are left out, Latin spellings do not agree on them.
+var arabicSounds = map[rune]string{
+	'ا': "", 'ء': "", 'ع': "", 'و': "", 'ي': "",
+	'ب': "b", 'پ': "b",
+	'ت': "t", 'ث': "t", 'ط': "t",
+	'ج': "j",
+	'ح': "h", 'ه': "h",
+	'خ': "kh",
+	'د': "d", 'ذ': "d", 'ض': "d", 'ظ': "d",
+	'ر': "r",
+	'ز': "z",
+	'س': "s", 'ص': "s",
+	'ش': "ch", 'چ': "ch",
+	'غ': "gh",
+	'ف': "f", 'ڤ': "f",
+	'ق': "k", 'ك': "k",
+	'ڨ': "g", 'گ': "g",
+	'ل': "l",
+	'م': "m",
+	'ن': "n",
+}
+
+// latinSounds maps Latin letters and the digits of the Derja chat alphabet
+// to the same consonants. Vowels are left out.
+var latinSounds = map[rune]string{
+	'a': "", 'e': "", 'i': "", 'o': "", 'u': "", 'y': "", 'w': "",
+	'c': "k", 'q': "k", 'p': "b", 'v': "f", 'x': "ks",
+	'2': "", '3': "", '5': "kh", '7': "h", '8': "gh", '9': "k",
+}
+
+// latinDigraphs are letter pairs that spell one Arabic consonant
+var latinDigraphs = map[string]string{
+	"ch": "ch", "sh": "ch", "kh": "kh", "gh": "gh",
+}
+
+// Skeleton returns the consonants of a folded word spelled in Latin letters,
+// with doubled letters written once. Words written in Arabic script, French
+// or Latin-script Derja share a skeleton when they sound alike: "سباك",
+// "sabbek" and "sabbak" are all "sbk", "بلومبي" and "plombi" are "blmb".
+func Skeleton(word string) string {
+	runes := []rune(word)
+	var b strings.Builder
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+		if i+1 < len(runes) {
+			if sound, ok := latinDigraphs[string(runes[i:i+2])]; ok {
+				b.WriteString(sound)
+				i++
+				continue
+			}
+		}
+		if r == 'ه' && i == len(runes)-1 {
+			continue // Mostly a folded ta marbuta, an ending vowel
+		}
+		if sound, ok := arabicSounds[r]; ok {
+			b.WriteString(sound)
+		} else if sound, ok := latinSounds[r]; ok {
+			b.WriteString(sound)
+		} else {
+			b.WriteRune(r)
+		}
+	}
+
+	var skeleton []rune
+	for _, r := range b.String() {
+		if len(skeleton) == 0 || skeleton[len(skeleton)-1] != r {
+			skeleton = append(skeleton, r)
+		}
+	}
+	return string(skeleton)
+}
+
+// Distance returns the number of letters to insert, delete, replace or swap
+// with a neighbour to turn a into b
+func Distance(a, b string) int {
+	s, t := []rune(a), []rune(b)
+	// Rows i-2, i-1 and i of the distance table
+	prev2 := make([]int, len(t)+1)
+	prev := make([]int, len(t)+1)
+	row := make([]int, len(t)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(s); i++ {
+		row[0] = i
+		for j := 1; j <= len(t); j++ {
+			cost := 1
+			if s[i-1] == t[j-1] {
+				cost = 0
+			}
+			row[j] = min(prev[j]+1, row[j-1]+1, prev[j-1]+cost)
+			if i > 1 && j > 1 && s[i-1] == t[j-2] && s[i-2] == t[j-1] {
+				row[j] = min(row[j], prev2[j-2]+1)
+			}
+		}
+		prev2, prev, row = prev, row, prev2
+	}
+	return prev[len(t)]
+}
@@ -0,0 +1,183 @@
+package search
+
+import (
+	"sort"
+	"strings"
+)
+
+// Quality tells how well a query matches, from no match to exact
+type Quality int
+
+const (
+	NoMatch Quality = iota
+	Fuzzy           // Typo within the edit distance, or same skeleton in another script
+	Prefix          // The word starts with the query, e.g. while typing
+	Exact
+)
+
+// tolerance is the edit distance allowed for a query word. Short words must
+// match exactly, or every three letter query would match half the list.
+func tolerance(word string) int {
+	switch n := len([]rune(word)); {
+	case n < 4:
+		return 0
+	case n < 7:
+		return 1
+	default:
+		return 2
+	}
+}
+
+// minSkeleton is the shortest skeleton compared across scripts
+const minSkeleton = 3
+
+// MatchWord tells how well the folded query word q matches the folded word w
+func MatchWord(q, w string) Quality {
+	if q == "" || w == "" {
+		return NoMatch
+	}
+	if q == w {
+		return Exact
+	}
+	if len([]rune(q)) >= 2 && strings.HasPrefix(w, q) {
+		return Prefix
+	}
+
+	if allowed := tolerance(q); allowed > 0 {
+		if Distance(q, w) <= allowed {
+			return Fuzzy
+		}
+		// A typo in a word still being typed
+		if wr := []rune(w); len(wr) > len([]rune(q)) && Distance(q, string(wr[:len([]rune(q))])) <= allowed {
+			return Fuzzy
+		}
+	}
+
+	sq := Skeleton(q)
+	if len([]rune(sq)) >= minSkeleton && strings.HasPrefix(Skeleton(w), sq) {
+		return Fuzzy
+	}
+	return NoMatch
+}
+
+// Match tells how well a query matches text: every word of the query has to
+// match a word of the text, and the weakest word sets the quality.
+// A query without words matches nothing.
+func Match(query, text string) Quality {
+	return matchWords(Words(query), Words(text))
+}
+
+func matchWords(query, words []string) Quality {
+	if len(query) == 0 {
+		return NoMatch
+	}
+	worst := Exact
+	for _, q := range query {
+		best := bestMatch(q, words)
+		if best == NoMatch {
+			return NoMatch
+		}
+		worst = min(worst, best)
+	}
+	return worst
+}
+
+// bestMatch returns how well the query word q matches the best of words
+func bestMatch(q string, words []string) Quality {
+	best := NoMatch
+	for _, w := range words {
+		if quality := MatchWord(q, w); quality > best {
+			best = quality
+			if best == Exact {
+				break
+			}
+		}
+	}
+	return best
+}
+
+// Category is a professional category with the other names people search
+// it by
+type Category struct {
+	Name     string   // As stored by the API, e.g. "Plumbing"
+	Synonyms []string // Trades and other names in any language, e.g. "plombier"
+}
+
+// Dictionary finds categories by their names and synonyms.
+// It is not changed after creation and safe for concurrent use.
+type Dictionary struct {
+	categories []Category
+	terms      [][][]string // Folded words of the name and each synonym, per category
+}
+
+// NewDictionary creates a dictionary of the categories, in display order
+func NewDictionary(categories ...Category) *Dictionary {
+	d := &Dictionary{categories: categories}
+	for _, c := range categories {
+		terms := [][]string{Words(c.Name)}
+		for _, synonym := range c.Synonyms {
+			terms = append(terms, Words(synonym))
+		}
+		d.terms = append(d.terms, terms)
+	}
+	return d
+}
+
+// Categories returns the names of the categories the query names, best
+// matches first and otherwise in dictionary order
+func (d *Dictionary) Categories(query string) []string {
+	words := Words(query)
+	type found struct {
+		name    string
+		quality Quality
+	}
+	var matches []found
+	for i, c := range d.categories {
+		best := NoMatch
+		for _, term := range d.terms[i] {
+			best = max(best, matchWords(words, term))
+		}
+		if best != NoMatch {
+			matches = append(matches, found{c.Name, best})
+		}
+	}
+	sort.SliceStable(matches, func(i, j int) bool { return matches[i].quality > matches[j].quality })
+
+	names := make([]string, len(matches))
+	for i, m := range matches {
+		names[i] = m.name
+	}
+	return names
+}
+
+// CategoryOf returns the category whose name or synonym is text, e.g.
+// "Plumbing" for a worker's profession "Plumber", or "" when there is none
+func (d *Dictionary) CategoryOf(text string) string {
+	folded := Fold(text)
+	for i, c := range d.categories {
+		for _, term := range d.terms[i] {
+			if strings.Join(term, " ") == folded {
+				return c.Name
+			}
+		}
+	}
+	return ""
+}
+
+// Matches reports whether a worker of the category with the given fields,
+// e.g. name and skills, is a result for the query. Every query word has to
+// match a word of the fields or a name of the category.
+func (d *Dictionary) Matches(query, category string, fields ...string) bool {
+	var words []string
+	for _, field := range fields {
+		words = append(words, Words(field)...)
+	}
+	for i, c := range d.categories {
+		if c.Name == category {
+			for _, term := range d.terms[i] {
+				words = append(words, term...)
+			}
+		}
+	}
+	return matchWords(Words(query), words) != NoMatch
+}
@@ -0,0 +1,214 @@
+package search
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestFold(t *testing.T) {
+	tests := []struct {
+		in, want string
+	}{
+		{"Plumber", "plumber"},
+		{"Électricité", "electricite"},
+		{"  Femme de   ménage! ", "femme de menage"},
+		{"fuite d'eau", "fuite d eau"},
+		{"Œuvre", "oeuvre"},
+		{"سَبَّاك", "سباك"},
+		{"ســبــاك", "سباك"},
+		{"أثاث", "اثاث"},
+		{"إصلاح", "اصلاح"},
+		{"سباكة", "سباكه"},
+		{"مستشفى", "مستشفي"},
+		{"٠٩٨", "098"},
+		{"tasli7", "tasli7"},
+	}
+	for _, tt := range tests {
+		if got := Fold(tt.in); got != tt.want {
+			t.Errorf("Fold(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestSkeleton(t *testing.T) {
+	tests := []struct {
+		in, want string
+	}{
+		{"سباك", "sbk"},
+		{"sabbek", "sbk"},
+		{"sabbak", "sbk"},
+		{"بلومبي", "blmb"},
+		{"plombi", "blmb"},
+		{"plombier", "blmbr"},
+		{"تريسيان", "trsn"},
+		{"trisien", "trsn"},
+		{"tasli7", "tslh"},
+		{"تصليح", "tslh"},
+		{"9ahwa", "kh"},
+		{"chaouch", "chch"},
+		{"شاوش", "chch"},
+		{"نظافه", "ndf"},
+		{"", ""},
+	}
+	for _, tt := range tests {
+		if got := Skeleton(tt.in); got != tt.want {
+			t.Errorf("Skeleton(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestDistance(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"", "", 0},
+		{"", "abc", 3},
+		{"plombier", "plombier", 0},
+		{"plombier", "plonbier", 1},
+		{"plombier", "plmobier", 1}, // Swapped letters
+		{"plombier", "plombiers", 1},
+		{"electricien", "electrisien", 1},
+		{"kitten", "sitting", 3},
+		{"سباك", "سباق", 1},
+	}
+	for _, tt := range tests {
+		if got := Distance(tt.a, tt.b); got != tt.want {
+			t.Errorf("Distance(%q, %q) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+		if got := Distance(tt.b, tt.a); got != tt.want {
+			t.Errorf("Distance(%q, %q) = %d, want %d", tt.b, tt.a, got, tt.want)
+		}
+	}
+}
+
+func TestMatchWord(t *testing.T) {
+	tests := []struct {
+		q, w string
+		want Quality
+	}{
+		{"plombier", "plombier", Exact},
+		{"plombi", "plombier", Prefix},
+		{"plonbier", "plombier", Fuzzy},
+		{"plonbi", "plombier", Fuzzy},
+		{"sabbek", "سباك", Fuzzy},
+		{"سباك", "sabbak", Fuzzy},
+		{"trisien", "تريسيان", Fuzzy},
+		{"p", "plombier", NoMatch},
+		{"pla", "plombier", NoMatch},
+		{"sami", "samir", Prefix},
+		{"sam", "sum", NoMatch}, // Short words must be exact
+		{"menuisier", "serrurier", NoMatch},
+		{"", "plombier", NoMatch},
+	}
+	for _, tt := range tests {
+		if got := MatchWord(tt.q, tt.w); got != tt.want {
+			t.Errorf("MatchWord(%q, %q) = %d, want %d", tt.q, tt.w, got, tt.want)
+		}
+	}
+}
+
+func TestMatch(t *testing.T) {
+	tests := []struct {
+		query, text string
+		want        Quality
+	}{
+		{"Femme de Ménage", "femme de ménage", Exact},
+		{"menage femme", "femme de ménage", Exact},
+		{"femme men", "femme de ménage", Prefix},
+		{"femme manage", "femme de ménage", Fuzzy},
+		{"femme jardin", "femme de ménage", NoMatch},
+		{"", "femme de ménage", NoMatch},
+	}
+	for _, tt := range tests {
+		if got := Match(tt.query, tt.text); got != tt.want {
+			t.Errorf("Match(%q, %q) = %d, want %d", tt.query, tt.text, got, tt.want)
+		}
+	}
+}
+
+func TestCategories(t *testing.T) {
+	tests := []struct {
+		query string
+		first string // Best match, "" when no category matches
+	}{
+		{"Plumbing", "Plumbing"},
+		{"plombier", "Plumbing"},
+		{"plombi", "Plumbing"},
+		{"سباك", "Plumbing"},
+		{"sabbek", "Plumbing"},
+		{"plonbier", "Plumbing"},
+		{"ÉLECTRICIEN", "Electricity"},
+		{"trisien", "Electricity"},
+		{"كهربائي", "Electricity"},
+		{"femme de menage", "Home Cleaning"},
+		{"tasli7", "Small Repairs"},
+		{"serrurier", "Locksmiths"},
+		{"clim", "AC Fixing"},
+		{"fuite", "Water Leakage"},
+		{"gardening", ""},
+		{"", ""},
+	}
+	for _, tt := range tests {
+		got := Default.Categories(tt.query)
+		first := ""
+		if len(got) > 0 {
+			first = got[0]
+		}
+		if first != tt.first {
+			t.Errorf("Categories(%q) = %q, want %q first", tt.query, got, tt.first)
+		}
+	}
+}
+
+func TestCategoriesOrder(t *testing.T) {
+	d := NewDictionary(
+		Category{"Repairs", []string{"plombiere"}},
+		Category{"Plumbing", []string{"plombier"}},
+	)
+	want := []string{"Plumbing", "Repairs"}
+	if got := d.Categories("plombier"); !reflect.DeepEqual(got, want) {
+		t.Errorf("Categories(\"plombier\") = %q, want %q, exact matches first", got, want)
+	}
+}
+
+func TestCategoryOf(t *testing.T) {
+	tests := []struct {
+		text, want string
+	}{
+		{"Plumber", "Plumbing"},
+		{"Plumbing", "Plumbing"},
+		{"AC Technician", "AC Fixing"},
+		{"Carpenter", "Furniture Assembly"},
+		{"سباك", "Plumbing"},
+		{"Plumb", ""},
+		{"Gardener", ""},
+	}
+	for _, tt := range tests {
+		if got := Default.CategoryOf(tt.text); got != tt.want {
+			t.Errorf("CategoryOf(%q) = %q, want %q", tt.text, got, tt.want)
+		}
+	}
+}
+
+func TestMatches(t *testing.T) {
+	tests := []struct {
+		query, category string
+		fields          []string
+		want            bool
+	}{
+		{"mohamed", "Plumbing", []string{"Mohamed Hassan", "Plumber"}, true},
+		{"plombier", "Plumbing", []string{"Mohamed Hassan", "Plumber"}, true},
+		{"سباك", "Plumbing", []string{"Mohamed Hassan", "Plumber"}, true},
+		{"plombier mohamed", "Plumbing", []string{"Mohamed Hassan", "Plumber"}, true},
+		{"plombier ali", "Plumbing", []string{"Mohamed Hassan", "Plumber"}, false},
+		{"plombier", "Electricity", []string{"Ahmed El-Sayed", "Electrician"}, false},
+		{"leak repairs", "", []string{"Tarek Maatoug", "Leak Repairs"}, true},
+		{"", "Plumbing", []string{"Mohamed Hassan"}, false},
+	}
+	for _, tt := range tests {
+		if got := Default.Matches(tt.query, tt.category, tt.fields...); got != tt.want {
+			t.Errorf("Matches(%q, %q, %q) = %v, want %v", tt.query, tt.category, tt.fields, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,81 @@
+package theme
+
+import (
+	"image/color"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/theme"
+)
+
+// Accent is the color of buttons, links and selections the user picked
+type Accent string
+
+const (
+	AccentBlue   Accent = "blue" // Brand blue, the default
+	AccentGreen  Accent = "green"
+	AccentOrange Accent = "orange"
+	AccentPurple Accent = "purple"
+	AccentTeal   Accent = "teal"
+)
+
+// Accents lists the accent colors in display order
+var Accents = []Accent{AccentBlue, AccentGreen, AccentOrange, AccentPurple, AccentTeal}
+
+// accentShades are the colors of an accent. The normal shade keeps 3:1
+// against white text, enough for buttons, the high contrast shades keep
+// 4.5:1 against white text in light mode and black text in dark mode.
+type accentShades struct {
+	normal            color.NRGBA
+	highContrastLight color.NRGBA
+	highContrastDark  color.NRGBA
+}
+
+var accentPalette = map[Accent]accentShades{
+	AccentBlue: {
+		normal:            color.NRGBA{R: 0x28, G: 0x7D, B: 0xF7, A: 0xFF},
+		highContrastLight: color.NRGBA{R: 0x0B, G: 0x57, B: 0xD0, A: 0xFF},
+		highContrastDark:  color.NRGBA{R: 0x8A, G: 0xB4, B: 0xF8, A: 0xFF},
+	},
+	AccentGreen: {
+		normal:            color.NRGBA{R: 0x1E, G: 0x9E, B: 0x5A, A: 0xFF},
+		highContrastLight: color.NRGBA{R: 0x13, G: 0x6C, B: 0x3A, A: 0xFF},
+		highContrastDark:  color.NRGBA{R: 0x6D, G: 0xD5, B: 0x8C, A: 0xFF},
+	},
+	AccentOrange: {
+		normal:            color.NRGBA{R: 0xE0, G: 0x6A, B: 0x00, A: 0xFF},
+		highContrastLight: color.NRGBA{R: 0xA8, G: 0x43, B: 0x00, A: 0xFF},
+		highContrastDark:  color.NRGBA{R: 0xFF, G: 0xB7, B: 0x4D, A: 0xFF},
+	},
+	AccentPurple: {
+		normal:            color.NRGBA{R: 0x7E, G: 0x57, B: 0xC2, A: 0xFF},
+		highContrastLight: color.NRGBA{R: 0x5E, G: 0x35, B: 0xB1, A: 0xFF},
+		highContrastDark:  color.NRGBA{R: 0xB3, G: 0x9D, B: 0xDB, A: 0xFF},
+	},
+	AccentTeal: {
+		normal:            color.NRGBA{R: 0x00, G: 0x89, B: 0x7B, A: 0xFF},
+		highContrastLight: color.NRGBA{R: 0x00, G: 0x69, B: 0x5C, A: 0xFF},
+		highContrastDark:  color.NRGBA{R: 0x4D, G: 0xB6, B: 0xAC, A: 0xFF},
+	},
+}
+
+// Color returns the accent color for a variant, unknown accents are brand blue
+func (a Accent) Color(variant fyne.ThemeVariant, highContrast bool) color.NRGBA {
+	shades, ok := accentPalette[a]
+	if !ok {
+		shades = accentPalette[AccentBlue]
+	}
+	switch {
+	case !highContrast:
+		return shades.normal
+	case variant == theme.VariantLight:
+		return shades.highContrastLight
+	default:
+		return shades.highContrastDark
+	}
+}
+
+// withAlpha returns the color with a new opacity, for focus and selection
+func withAlpha(c color.NRGBA, alpha uint8) color.NRGBA {
+	c.A = alpha
+	return c
+}
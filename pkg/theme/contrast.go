@@ -0,0 +1,35 @@
+package theme
+
+import (
+	"image/color"
+	"math"
+)
+
+// Minimum WCAG 2 contrast ratios for level AA
+const (
+	ContrastAAText      = 4.5 // Body text
+	ContrastAALargeText = 3.0 // Text from 18pt, or 14pt bold, and UI component borders
+)
+
+// ContrastRatio returns the WCAG 2 contrast ratio of two opaque colors,
+// from 1 for the same color to 21 for black on white
+func ContrastRatio(a, b color.Color) float64 {
+	la, lb := relativeLuminance(a), relativeLuminance(b)
+	if la < lb {
+		la, lb = lb, la
+	}
+	return (la + 0.05) / (lb + 0.05)
+}
+
+// relativeLuminance is the WCAG 2 relative luminance of an sRGB color
+func relativeLuminance(c color.Color) float64 {
+	r, g, b, _ := color.NRGBAModel.Convert(c).RGBA()
+	linear := func(v uint32) float64 {
+		s := float64(v) / 0xFFFF
+		if s <= 0.04045 {
+			return s / 12.92
+		}
+		return math.Pow((s+0.055)/1.055, 2.4)
+	}
+	return 0.2126*linear(r) + 0.7152*linear(g) + 0.0722*linear(b)
+}
@@ -1,3 +1,11 @@
 // Package theme provides custom theming for the SkillKonnect application.
 // It includes support for both light and dark theme variants with custom branding.
+//
+// Besides colors the theme sets the type scale, spacing and corner radii as
+// theme sizes, and the branded icons and fonts bundled from the fonts and
+// icons directories. Screens use the size names instead of fixed numbers.
+// After adding a font or icon, run go generate.
 package theme
+
+//go:generate fyne bundle -o bundled.go --package theme fonts/Amiri-Regular.ttf
+//go:generate fyne bundle -o bundled.go --package theme -a icons
@@ -0,0 +1,68 @@
+package theme
+
+import (
+	"image/color"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/theme"
+)
+
+// highContrastLight and highContrastDark replace the colors of the high
+// contrast variant. Text colors keep at least 4.5:1 against the backgrounds
+// they are drawn on and borders 3:1, as WCAG AA asks. Primary, hyperlink,
+// focus and selection come from the accent.
+var highContrastLight = map[fyne.ThemeColorName]color.Color{
+	theme.ColorNameBackground:          color.NRGBA{R: 0xFF, G: 0xFF, B: 0xFF, A: 0xFF},
+	theme.ColorNameForeground:          color.NRGBA{R: 0x00, G: 0x00, B: 0x00, A: 0xFF},
+	theme.ColorNamePlaceHolder:         color.NRGBA{R: 0x59, G: 0x59, B: 0x59, A: 0xFF},
+	theme.ColorNameDisabled:            color.NRGBA{R: 0x6E, G: 0x6E, B: 0x6E, A: 0xFF},
+	theme.ColorNameInputBackground:     color.NRGBA{R: 0xFF, G: 0xFF, B: 0xFF, A: 0xFF},
+	theme.ColorNameInputBorder:         color.NRGBA{R: 0x59, G: 0x59, B: 0x59, A: 0xFF},
+	theme.ColorNameSeparator:           color.NRGBA{R: 0x59, G: 0x59, B: 0x59, A: 0xFF},
+	theme.ColorNameButton:              color.NRGBA{R: 0xEB, G: 0xEB, B: 0xEB, A: 0xFF},
+	theme.ColorNameHeaderBackground:    color.NRGBA{R: 0xFF, G: 0xFF, B: 0xFF, A: 0xFF},
+	theme.ColorNameMenuBackground:      color.NRGBA{R: 0xFF, G: 0xFF, B: 0xFF, A: 0xFF},
+	theme.ColorNameOverlayBackground:   color.NRGBA{R: 0xFF, G: 0xFF, B: 0xFF, A: 0xFF},
+	theme.ColorNameForegroundOnPrimary: color.NRGBA{R: 0xFF, G: 0xFF, B: 0xFF, A: 0xFF},
+	theme.ColorNameError:               color.NRGBA{R: 0xB0, G: 0x00, B: 0x20, A: 0xFF},
+	theme.ColorNameForegroundOnError:   color.NRGBA{R: 0xFF, G: 0xFF, B: 0xFF, A: 0xFF},
+	theme.ColorNameSuccess:             color.NRGBA{R: 0x1B, G: 0x5E, B: 0x20, A: 0xFF},
+	theme.ColorNameForegroundOnSuccess: color.NRGBA{R: 0xFF, G: 0xFF, B: 0xFF, A: 0xFF},
+	theme.ColorNameWarning:             color.NRGBA{R: 0x8A, G: 0x4B, B: 0x00, A: 0xFF},
+	theme.ColorNameForegroundOnWarning: color.NRGBA{R: 0xFF, G: 0xFF, B: 0xFF, A: 0xFF},
+	ColorNameHighlight:                 color.NRGBA{R: 0xFF, G: 0xF1, B: 0xB8, A: 0xFF},
+	ColorNameNavBar:                    color.NRGBA{R: 0xFF, G: 0xFF, B: 0xFF, A: 0xFF},
+}
+
+var highContrastDark = map[fyne.ThemeColorName]color.Color{
+	theme.ColorNameBackground:          color.NRGBA{R: 0x00, G: 0x00, B: 0x00, A: 0xFF},
+	theme.ColorNameForeground:          color.NRGBA{R: 0xFF, G: 0xFF, B: 0xFF, A: 0xFF},
+	theme.ColorNamePlaceHolder:         color.NRGBA{R: 0xB3, G: 0xB3, B: 0xB3, A: 0xFF},
+	theme.ColorNameDisabled:            color.NRGBA{R: 0x9E, G: 0x9E, B: 0x9E, A: 0xFF},
+	theme.ColorNameInputBackground:     color.NRGBA{R: 0x00, G: 0x00, B: 0x00, A: 0xFF},
+	theme.ColorNameInputBorder:         color.NRGBA{R: 0xB3, G: 0xB3, B: 0xB3, A: 0xFF},
+	theme.ColorNameSeparator:           color.NRGBA{R: 0xB3, G: 0xB3, B: 0xB3, A: 0xFF},
+	theme.ColorNameButton:              color.NRGBA{R: 0x24, G: 0x24, B: 0x24, A: 0xFF},
+	theme.ColorNameHeaderBackground:    color.NRGBA{R: 0x00, G: 0x00, B: 0x00, A: 0xFF},
+	theme.ColorNameMenuBackground:      color.NRGBA{R: 0x12, G: 0x12, B: 0x12, A: 0xFF},
+	theme.ColorNameOverlayBackground:   color.NRGBA{R: 0x12, G: 0x12, B: 0x12, A: 0xFF},
+	theme.ColorNameForegroundOnPrimary: color.NRGBA{R: 0x00, G: 0x00, B: 0x00, A: 0xFF},
+	theme.ColorNameError:               color.NRGBA{R: 0xFF, G: 0x8A, B: 0x80, A: 0xFF},
+	theme.ColorNameForegroundOnError:   color.NRGBA{R: 0x00, G: 0x00, B: 0x00, A: 0xFF},
+	theme.ColorNameSuccess:             color.NRGBA{R: 0x81, G: 0xC7, B: 0x84, A: 0xFF},
+	theme.ColorNameForegroundOnSuccess: color.NRGBA{R: 0x00, G: 0x00, B: 0x00, A: 0xFF},
+	theme.ColorNameWarning:             color.NRGBA{R: 0xFF, G: 0xB7, B: 0x4D, A: 0xFF},
+	theme.ColorNameForegroundOnWarning: color.NRGBA{R: 0x00, G: 0x00, B: 0x00, A: 0xFF},
+	ColorNameHighlight:                 color.NRGBA{R: 0x33, G: 0x2B, B: 0x00, A: 0xFF},
+	ColorNameNavBar:                    color.NRGBA{R: 0x00, G: 0x00, B: 0x00, A: 0xFF},
+}
+
+// highContrastColor returns the high contrast color for the name, if it has one
+func highContrastColor(name fyne.ThemeColorName, variant fyne.ThemeVariant) (color.Color, bool) {
+	palette := highContrastDark
+	if variant == theme.VariantLight {
+		palette = highContrastLight
+	}
+	c, ok := palette[name]
+	return c, ok
+}
@@ -0,0 +1,25 @@
+package theme
+
+import (
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/theme"
+)
+
+// brandIcons are the bundled versions of standard icons, drawn with rounded
+// shapes to match the brand. They are tinted like the default icons.
+var brandIcons = map[fyne.ThemeIconName]fyne.Resource{
+	theme.IconNameNavigateBack: resourceArrowbackSvg,
+	theme.IconNameNavigateNext: resourceArrowforwardSvg,
+	theme.IconNameHome:         resourceHomeSvg,
+	theme.IconNameAccount:      resourceAccountSvg,
+	theme.IconNameSearch:       resourceSearchSvg,
+	theme.IconNameSettings:     resourceSettingsSvg,
+}
+
+// Icon returns the branded icon for the name, or the default one
+func (t SkillKonnectTheme) Icon(name fyne.ThemeIconName) fyne.Resource {
+	if icon, ok := brandIcons[name]; ok {
+		return theme.NewThemedResource(icon)
+	}
+	return theme.DefaultTheme().Icon(name)
+}
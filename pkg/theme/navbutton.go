@@ -1,6 +1,8 @@
 package theme
 
 import (
+	"image/color"
+
 	"fyne.io/fyne/v2"
 	"fyne.io/fyne/v2/canvas"
 	"fyne.io/fyne/v2/container"
@@ -47,7 +49,8 @@ func (b *NavButton) CreateRenderer() fyne.WidgetRenderer {
 	label.Alignment = fyne.TextAlignCenter
 	label.TextStyle = fyne.TextStyle{Bold: false}
 
-	content := container.NewCenter(label)
+	// The active button's label sits on the primary color
+	content := container.NewCenter(container.NewThemeOverride(label, navLabelTheme{button: b}))
 
 	// Create background with proper color
 	var bgColor fyne.ThemeColorName
@@ -102,3 +105,28 @@ func (r *navButtonRenderer) Objects() []fyne.CanvasObject {
 }
 
 func (r *navButtonRenderer) Destroy() {}
+
+// navLabelTheme draws the label of the active button in the text color for
+// the primary background, otherwise it is the app theme
+type navLabelTheme struct {
+	button *NavButton
+}
+
+func (t navLabelTheme) Color(name fyne.ThemeColorName, variant fyne.ThemeVariant) color.Color {
+	if name == theme.ColorNameForeground && t.button.Active {
+		name = theme.ColorNameForegroundOnPrimary
+	}
+	return fyne.CurrentApp().Settings().Theme().Color(name, variant)
+}
+
+func (t navLabelTheme) Font(style fyne.TextStyle) fyne.Resource {
+	return fyne.CurrentApp().Settings().Theme().Font(style)
+}
+
+func (t navLabelTheme) Icon(name fyne.ThemeIconName) fyne.Resource {
+	return fyne.CurrentApp().Settings().Theme().Icon(name)
+}
+
+func (t navLabelTheme) Size(name fyne.ThemeSizeName) float32 {
+	return fyne.CurrentApp().Settings().Theme().Size(name)
+}
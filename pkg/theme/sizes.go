@@ -0,0 +1,58 @@
+package theme
+
+import (
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/theme"
+)
+
+// Type scale, from the largest. Body text, headings and captions use the
+// standard size names: HeadingText 24, SubHeadingText 18, Text 14 and
+// CaptionText 12.
+const (
+	SizeNameDisplayText fyne.ThemeSizeName = "displayText" // Prices and key figures, 28
+	SizeNameTitleText   fyne.ThemeSizeName = "titleText"   // Names in headers and icons on action buttons, 20
+)
+
+// Spacing between and around elements
+const (
+	SizeNameSpacingSmall  fyne.ThemeSizeName = "spacingSmall"  // Between an icon and its label, 4
+	SizeNameSpacingMedium fyne.ThemeSizeName = "spacingMedium" // Between items of a list, 8
+	SizeNameSpacingLarge  fyne.ThemeSizeName = "spacingLarge"  // Around cards and sections, 16
+)
+
+// Corner radii. Entries and buttons use the standard InputRadius 8.
+const (
+	SizeNameRadiusCard  fyne.ThemeSizeName = "radiusCard"  // Cards like the price and stat cards, 12
+	SizeNameRadiusBadge fyne.ThemeSizeName = "radiusBadge" // Small badges and chips, 6
+)
+
+// Size returns the type scale, spacing and radii, other sizes come from the default theme
+func (t SkillKonnectTheme) Size(name fyne.ThemeSizeName) float32 {
+	switch name {
+	case SizeNameDisplayText:
+		return 28
+	case SizeNameTitleText:
+		return 20
+	case theme.SizeNameHeadingText:
+		return 24
+	case theme.SizeNameSubHeadingText:
+		return 18
+	case theme.SizeNameText:
+		return 14
+	case theme.SizeNameCaptionText:
+		return 12
+	case SizeNameSpacingSmall:
+		return 4
+	case SizeNameSpacingMedium:
+		return 8
+	case SizeNameSpacingLarge:
+		return 16
+	case SizeNameRadiusCard:
+		return 12
+	case SizeNameRadiusBadge, theme.SizeNameSelectionRadius:
+		return 6
+	case theme.SizeNameInputRadius:
+		return 8
+	}
+	return theme.DefaultTheme().Size(name)
+}
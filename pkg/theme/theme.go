@@ -8,16 +8,73 @@ import (
 )
 
 type SkillKonnectTheme struct {
-	variant fyne.ThemeVariant
+	variant      fyne.ThemeVariant
+	followSystem bool // Use the variant the system asks for instead
+	arabicText   bool // Draw text with the bundled Arabic font
+	highContrast bool // Use the high contrast colors
+	accent       Accent
+}
+
+// Option changes how a SkillKonnectTheme looks
+type Option func(*SkillKonnectTheme)
+
+// WithArabicText draws text with the bundled Amiri font, which covers Arabic
+// as well as Latin letters. Use it when the app language is Arabic; other
+// languages keep the default Noto Sans, with Arabic names falling back to
+// the system fonts. Amiri is only bundled in regular, so bold text is
+// drawn regular.
+func WithArabicText() Option {
+	return func(t *SkillKonnectTheme) {
+		t.arabicText = true
+	}
+}
+
+// WithHighContrast uses colors that meet the WCAG AA contrast ratios,
+// in the light or dark variant
+func WithHighContrast() Option {
+	return func(t *SkillKonnectTheme) {
+		t.highContrast = true
+	}
+}
+
+// WithAccent colors buttons, selections and the active tab with the accent
+// instead of brand blue
+func WithAccent(accent Accent) Option {
+	return func(t *SkillKonnectTheme) {
+		t.accent = accent
+	}
 }
 
 // NewSkillKonnectTheme creates a new theme with the specified variant (light or dark)
 // Usage examples:
 //
-//	NewSkillKonnectTheme(theme.VariantLight)  // Light theme
-//	NewSkillKonnectTheme(theme.VariantDark)   // Dark theme
-func NewSkillKonnectTheme(variant fyne.ThemeVariant) fyne.Theme {
-	return SkillKonnectTheme{variant: variant}
+//	NewSkillKonnectTheme(theme.VariantLight)                    // Light theme
+//	NewSkillKonnectTheme(theme.VariantDark)                     // Dark theme
+//	NewSkillKonnectTheme(theme.VariantLight, WithArabicText())  // Light theme for Arabic
+func NewSkillKonnectTheme(variant fyne.ThemeVariant, options ...Option) fyne.Theme {
+	t := SkillKonnectTheme{variant: variant, accent: AccentBlue}
+	for _, option := range options {
+		option(&t)
+	}
+	return t
+}
+
+// NewSystemSkillKonnectTheme creates a theme that is light or dark as the
+// system setting is, switching when the system setting changes
+func NewSystemSkillKonnectTheme(options ...Option) fyne.Theme {
+	t := SkillKonnectTheme{followSystem: true, accent: AccentBlue}
+	for _, option := range options {
+		option(&t)
+	}
+	return t
+}
+
+// resolve returns the variant to draw, given the one the system asks for
+func (t SkillKonnectTheme) resolve(variant fyne.ThemeVariant) fyne.ThemeVariant {
+	if t.followSystem {
+		return variant
+	}
+	return t.variant
 }
 
 // Custom color names
@@ -28,42 +85,58 @@ const (
 
 // Color lets you override specific named colors.
 func (t SkillKonnectTheme) Color(name fyne.ThemeColorName, variant fyne.ThemeVariant) color.Color {
+	variant = t.resolve(variant)
 	switch name {
 	case theme.ColorNamePrimary:
-		return color.RGBA{R: 0x28, G: 0x7D, B: 0xF7, A: 0xFF} // brand blue
+		return t.accent.Color(variant, t.highContrast) // brand blue unless the user picked another accent
+	case theme.ColorNameFocus:
+		if t.highContrast {
+			return withAlpha(t.accent.Color(variant, true), 0x7F) // Easier to spot
+		}
+		return withAlpha(t.accent.Color(variant, false), 0x2A)
+	case theme.ColorNameSelection:
+		return withAlpha(t.accent.Color(variant, t.highContrast), 0x40)
+	case theme.ColorNameHyperlink:
+		if t.highContrast {
+			return t.accent.Color(variant, true)
+		}
+	}
+	if t.highContrast {
+		if c, ok := highContrastColor(name, variant); ok {
+			return c
+		}
+	}
+
+	switch name {
 	case theme.ColorNameBackground:
-		if t.variant == theme.VariantLight {
+		if variant == theme.VariantLight {
 			return color.RGBA{R: 0xF5, G: 0xF5, B: 0xF5, A: 0xFF} // Light gray background
 		}
 		return color.RGBA{R: 0x1A, G: 0x1A, B: 0x1A, A: 0xFF} // Dark background
 	case theme.ColorNameForeground:
-		if t.variant == theme.VariantLight {
+		if variant == theme.VariantLight {
 			return color.RGBA{R: 0x20, G: 0x20, B: 0x20, A: 0xFF} // Dark text for light mode
 		}
 		return color.RGBA{R: 0xE0, G: 0xE0, B: 0xE0, A: 0xFF} // Light text for dark mode
 	case ColorNameHighlight:
-		if t.variant == theme.VariantLight {
+		if variant == theme.VariantLight {
 			return color.RGBA{R: 0xFF, G: 0xF8, B: 0xDC, A: 0xFF} // Light yellow/cream highlight
 		}
 		return color.RGBA{R: 0x3A, G: 0x35, B: 0x25, A: 0xFF} // Dark mode highlight
 	case ColorNameNavBar:
-		if t.variant == theme.VariantLight {
+		if variant == theme.VariantLight {
 			return color.RGBA{R: 0xFF, G: 0xFF, B: 0xFF, A: 0xFF} // White navbar for light mode
 		}
 		return color.RGBA{R: 0x0F, G: 0x0F, B: 0x0F, A: 0xFF} // Very dark navbar for dark mode (almost black)
 	}
 	// Use the theme's variant (can be light or dark)
-	return theme.DefaultTheme().Color(name, t.variant)
+	return theme.DefaultTheme().Color(name, variant)
 }
 
+// Font returns the bundled Arabic font when enabled, the default fonts otherwise
 func (t SkillKonnectTheme) Font(style fyne.TextStyle) fyne.Resource {
+	if t.arabicText && !style.Monospace && !style.Symbol {
+		return resourceAmiriRegularTtf
+	}
 	return theme.DefaultTheme().Font(style)
 }
-
-func (t SkillKonnectTheme) Size(name fyne.ThemeSizeName) float32 {
-	return theme.DefaultTheme().Size(name)
-}
-
-func (t SkillKonnectTheme) Icon(name fyne.ThemeIconName) fyne.Resource {
-	return theme.DefaultTheme().Icon(name)
-}
@@ -0,0 +1,131 @@
+package theme
+
+import (
+	"image/color"
+	"math"
+	"testing"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/test"
+	"fyne.io/fyne/v2/theme"
+)
+
+// colorPair is text or a border drawn in one theme color over another
+type colorPair struct {
+	fg, bg fyne.ThemeColorName
+	min    float64
+}
+
+// highContrastPairs are the color combinations the app draws, all of them
+// must meet WCAG AA in the high contrast variant
+var highContrastPairs = []colorPair{
+	{theme.ColorNameForeground, theme.ColorNameBackground, ContrastAAText},
+	{theme.ColorNameForeground, theme.ColorNameInputBackground, ContrastAAText},
+	{theme.ColorNamePlaceHolder, theme.ColorNameInputBackground, ContrastAAText},
+	{theme.ColorNameForeground, theme.ColorNameButton, ContrastAAText},
+	{theme.ColorNameForeground, theme.ColorNameOverlayBackground, ContrastAAText},
+	{theme.ColorNameForeground, theme.ColorNameMenuBackground, ContrastAAText},
+	{theme.ColorNameForeground, ColorNameHighlight, ContrastAAText},
+	{theme.ColorNameForeground, ColorNameNavBar, ContrastAAText},
+	{theme.ColorNameForegroundOnPrimary, theme.ColorNamePrimary, ContrastAAText},
+	{theme.ColorNamePrimary, theme.ColorNameBackground, ContrastAAText},
+	{theme.ColorNameHyperlink, theme.ColorNameBackground, ContrastAAText},
+	{theme.ColorNameError, theme.ColorNameBackground, ContrastAAText},
+	{theme.ColorNameForegroundOnError, theme.ColorNameError, ContrastAAText},
+	{theme.ColorNameSuccess, theme.ColorNameBackground, ContrastAAText},
+	{theme.ColorNameForegroundOnSuccess, theme.ColorNameSuccess, ContrastAAText},
+	{theme.ColorNameWarning, theme.ColorNameBackground, ContrastAAText},
+	{theme.ColorNameForegroundOnWarning, theme.ColorNameWarning, ContrastAAText},
+	{theme.ColorNameInputBorder, theme.ColorNameInputBackground, ContrastAALargeText},
+	{theme.ColorNameSeparator, theme.ColorNameBackground, ContrastAALargeText},
+}
+
+// brandPairs must hold in the normal variants too. Text on the accent is
+// button and tab text, held to the large text ratio.
+var brandPairs = []colorPair{
+	{theme.ColorNameForeground, theme.ColorNameBackground, ContrastAAText},
+	{theme.ColorNameForeground, ColorNameHighlight, ContrastAAText},
+	{theme.ColorNameForeground, ColorNameNavBar, ContrastAAText},
+	{theme.ColorNameForegroundOnPrimary, theme.ColorNamePrimary, ContrastAALargeText},
+}
+
+var variantNames = map[fyne.ThemeVariant]string{
+	theme.VariantLight: "light",
+	theme.VariantDark:  "dark",
+}
+
+func checkPairs(t *testing.T, th fyne.Theme, variant fyne.ThemeVariant, pairs []colorPair) {
+	t.Helper()
+	for _, p := range pairs {
+		ratio := ContrastRatio(th.Color(p.fg, variant), th.Color(p.bg, variant))
+		t.Logf("%s on %s: %.2f", p.fg, p.bg, ratio)
+		if ratio < p.min {
+			t.Errorf("%s on %s: contrast %.2f, want at least %.1f", p.fg, p.bg, ratio, p.min)
+		}
+	}
+}
+
+func TestContrastRatio(t *testing.T) {
+	black := color.NRGBA{A: 0xFF}
+	white := color.NRGBA{R: 0xFF, G: 0xFF, B: 0xFF, A: 0xFF}
+	tests := []struct {
+		a, b color.Color
+		want float64
+	}{
+		{black, white, 21},
+		{white, black, 21},
+		{white, white, 1},
+		{color.NRGBA{R: 0x76, G: 0x76, B: 0x76, A: 0xFF}, white, 4.54},
+		{color.NRGBA{R: 0x28, G: 0x7D, B: 0xF7, A: 0xFF}, white, 3.92},
+	}
+	for _, tt := range tests {
+		if got := ContrastRatio(tt.a, tt.b); math.Abs(got-tt.want) > 0.01 {
+			t.Errorf("ContrastRatio(%v, %v) = %.3f, want %.2f", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestHighContrastMeetsAA(t *testing.T) {
+	test.NewTempApp(t)
+	for variant, name := range variantNames {
+		for _, accent := range Accents {
+			t.Run(name+"/"+string(accent), func(t *testing.T) {
+				th := NewSkillKonnectTheme(variant, WithHighContrast(), WithAccent(accent))
+				checkPairs(t, th, variant, highContrastPairs)
+			})
+		}
+	}
+}
+
+func TestBrandColorsContrast(t *testing.T) {
+	test.NewTempApp(t)
+	for variant, name := range variantNames {
+		for _, accent := range Accents {
+			t.Run(name+"/"+string(accent), func(t *testing.T) {
+				th := NewSkillKonnectTheme(variant, WithAccent(accent))
+				checkPairs(t, th, variant, brandPairs)
+			})
+		}
+	}
+}
+
+func TestSystemThemeFollowsVariant(t *testing.T) {
+	test.NewTempApp(t)
+	th := NewSystemSkillKonnectTheme(WithHighContrast())
+	for variant := range variantNames {
+		want := NewSkillKonnectTheme(variant, WithHighContrast()).Color(theme.ColorNameBackground, variant)
+		if got := th.Color(theme.ColorNameBackground, variant); got != want {
+			t.Errorf("variant %d: background %v, want %v", variant, got, want)
+		}
+	}
+}
+
+func TestUnknownAccentIsBrandBlue(t *testing.T) {
+	for _, highContrast := range []bool{false, true} {
+		for variant := range variantNames {
+			if got, want := Accent("pink").Color(variant, highContrast), AccentBlue.Color(variant, highContrast); got != want {
+				t.Errorf("Accent(pink).Color(%d, %v) = %v, want %v", variant, highContrast, got, want)
+			}
+		}
+	}
+}
@@ -0,0 +1,149 @@
+package theme
+
+import (
+	"image/color"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+)
+
+// The canvas primitives keep the color they were created with. These
+// widgets hold a theme color name instead and look it up again on every
+// refresh, so they follow theme switches without rebuilding the screen.
+
+// ThemedRectangle is a rectangle filled with a theme color
+type ThemedRectangle struct {
+	widget.BaseWidget
+	ColorName  fyne.ThemeColorName
+	RadiusName fyne.ThemeSizeName // Corner radius, e.g. SizeNameRadiusCard, square when empty
+
+	minSize fyne.Size
+}
+
+// NewThemedRectangle creates a rectangle filled with the named theme color
+func NewThemedRectangle(name fyne.ThemeColorName) *ThemedRectangle {
+	r := &ThemedRectangle{ColorName: name}
+	r.ExtendBaseWidget(r)
+	return r
+}
+
+// SetMinSize sets the smallest size of the rectangle
+func (r *ThemedRectangle) SetMinSize(size fyne.Size) {
+	r.minSize = size
+	r.Refresh()
+}
+
+func (r *ThemedRectangle) MinSize() fyne.Size {
+	return r.minSize
+}
+
+func (r *ThemedRectangle) CreateRenderer() fyne.WidgetRenderer {
+	rect := canvas.NewRectangle(color.Transparent)
+	return &themedRenderer{object: rect, refresh: func() {
+		rect.FillColor = theme.Color(r.ColorName)
+		rect.CornerRadius = 0
+		if r.RadiusName != "" {
+			rect.CornerRadius = theme.Size(r.RadiusName)
+		}
+	}}
+}
+
+// ThemedCircle is a circle filled with a theme color.
+// Override, when set, is shown instead, e.g. as tap feedback.
+type ThemedCircle struct {
+	widget.BaseWidget
+	ColorName fyne.ThemeColorName
+	Override  color.Color
+}
+
+// NewThemedCircle creates a circle filled with the named theme color
+func NewThemedCircle(name fyne.ThemeColorName) *ThemedCircle {
+	c := &ThemedCircle{ColorName: name}
+	c.ExtendBaseWidget(c)
+	return c
+}
+
+func (c *ThemedCircle) CreateRenderer() fyne.WidgetRenderer {
+	circle := canvas.NewCircle(color.Transparent)
+	return &themedRenderer{object: circle, refresh: func() {
+		if c.Override != nil {
+			circle.FillColor = c.Override
+		} else {
+			circle.FillColor = theme.Color(c.ColorName)
+		}
+	}}
+}
+
+// ThemedText is a single line of text in a theme color, for headers drawn
+// on colored backgrounds where a Label's foreground color would not do
+type ThemedText struct {
+	widget.BaseWidget
+	Text      string
+	ColorName fyne.ThemeColorName
+	SizeName  fyne.ThemeSizeName // Size in the type scale, theme.SizeNameText when empty
+	TextStyle fyne.TextStyle
+	Alignment fyne.TextAlign
+}
+
+// NewThemedText creates text in the named theme color
+func NewThemedText(text string, name fyne.ThemeColorName) *ThemedText {
+	t := &ThemedText{Text: text, ColorName: name}
+	t.ExtendBaseWidget(t)
+	return t
+}
+
+// SetText changes the text
+func (t *ThemedText) SetText(text string) {
+	t.Text = text
+	t.Refresh()
+}
+
+func (t *ThemedText) CreateRenderer() fyne.WidgetRenderer {
+	text := canvas.NewText("", color.Transparent)
+	return &themedRenderer{object: text, refresh: func() {
+		text.Text = t.Text
+		text.Color = theme.Color(t.ColorName)
+		text.TextSize = theme.TextSize()
+		if t.SizeName != "" {
+			text.TextSize = theme.Size(t.SizeName)
+		}
+		text.TextStyle = t.TextStyle
+		text.Alignment = t.Alignment
+	}}
+}
+
+// themedRenderer draws one canvas object, refresh copies the widget's
+// current theme colors and properties onto it
+type themedRenderer struct {
+	object  fyne.CanvasObject
+	refresh func()
+	applied bool
+}
+
+func (r *themedRenderer) Layout(size fyne.Size) {
+	r.object.Resize(size)
+}
+
+func (r *themedRenderer) MinSize() fyne.Size {
+	if !r.applied {
+		r.Refresh()
+	}
+	if _, ok := r.object.(*canvas.Text); ok {
+		return r.object.MinSize()
+	}
+	return fyne.NewSize(0, 0)
+}
+
+func (r *themedRenderer) Refresh() {
+	r.applied = true
+	r.refresh()
+	r.object.Refresh()
+}
+
+func (r *themedRenderer) Objects() []fyne.CanvasObject {
+	return []fyne.CanvasObject{r.object}
+}
+
+func (r *themedRenderer) Destroy() {}
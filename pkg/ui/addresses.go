@@ -0,0 +1,275 @@
+package ui
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+
+	"fyne.io/fyne/v2"
+
+	"skillDar/pkg/i18n"
+)
+
+// prefAddressesCache caches the saved addresses for offline booking, the
+// user ID is appended so one user never sees another's door codes.
+// Caches of older versions used the bare key for every user.
+const prefAddressesCache = "addresses.cache"
+
+// addressLabels are the suggested names for a saved address, in English.
+// They are translated where they are offered.
+var addressLabels = []string{"Home", "Work", "Parents' house"}
+
+// Address is a place the client books services at
+type Address struct {
+	ID          string  `json:"id,omitempty"`
+	Label       string  `json:"label"` // e.g. "Home"
+	Street      string  `json:"street"`
+	Building    string  `json:"building,omitempty"` // Building or residence name
+	City        string  `json:"city"`
+	PostalCode  string  `json:"postal_code,omitempty"`
+	Floor       string  `json:"floor,omitempty"`
+	DoorCode    string  `json:"door_code,omitempty"`
+	AccessNotes string  `json:"access_notes,omitempty"` // e.g. "Ring twice, blue gate"
+	Location    *LatLon `json:"location,omitempty"`
+}
+
+// Line returns the address on one line, without the access details
+func (a Address) Line() string {
+	var parts []string
+	for _, part := range []string{a.Building, a.Street, strings.TrimSpace(a.PostalCode + " " + a.City)} {
+		if part != "" {
+			parts = append(parts, part)
+		}
+	}
+	return strings.Join(parts, ", ")
+}
+
+// Access returns the floor, door code and notes a worker needs to get in
+func (a Address) Access() string {
+	var parts []string
+	if a.Floor != "" {
+		parts = append(parts, i18n.T("Floor %s", a.Floor))
+	}
+	if a.DoorCode != "" {
+		parts = append(parts, i18n.T("Door code %s", a.DoorCode))
+	}
+	if a.AccessNotes != "" {
+		parts = append(parts, a.AccessNotes)
+	}
+	return strings.Join(parts, " · ")
+}
+
+// ValidateAddress checks an address before it is saved.
+// It returns a map of field name to error message, empty when the address is valid.
+func ValidateAddress(a Address) map[string]string {
+	errs := make(map[string]string)
+
+	if a.Label == "" {
+		errs["label"] = i18n.T("Name this address, e.g. Home")
+	}
+	if a.Street == "" {
+		errs["street"] = i18n.T("Enter the street and number")
+	}
+	if a.City == "" {
+		errs["city"] = i18n.T("Enter the city")
+	}
+	if a.PostalCode != "" && (len(a.PostalCode) != 4 || strings.Trim(a.PostalCode, "0123456789") != "") {
+		errs["postal_code"] = i18n.T("Postal codes have 4 digits")
+	}
+	if a.Location == nil {
+		errs["location"] = i18n.T("Tap the map to pin the entrance")
+	}
+
+	return errs
+}
+
+// AddressStore keeps the client's saved addresses. The list is cached in
+// preferences per user so addresses can be picked while offline; changes
+// need the server.
+type AddressStore struct {
+	mu        sync.Mutex
+	prefs     fyne.Preferences
+	config    *APIConfig
+	userID    string // Whose addresses are cached, none until SetUser
+	addresses []Address
+	listeners listenerList
+}
+
+// NewAddressStore creates an empty address store, call SetUser after login
+func NewAddressStore(app fyne.App, config *APIConfig) *AddressStore {
+	as := &AddressStore{prefs: app.Preferences(), config: config}
+	removePreference(as.prefs, prefAddressesCache)
+	return as
+}
+
+// SetUser loads the cached addresses of the signed-in user
+func (as *AddressStore) SetUser(userID string) {
+	as.mu.Lock()
+	as.userID = userID
+	as.addresses = nil
+	if data := as.prefs.String(as.cacheKey()); userID != "" && data != "" {
+		if err := json.Unmarshal([]byte(data), &as.addresses); err != nil {
+			fyne.LogError("Failed to read saved addresses", err)
+		}
+	}
+	as.mu.Unlock()
+	as.notify()
+}
+
+// Clear forgets the addresses and deletes the user's cache, e.g. on logout
+func (as *AddressStore) Clear() {
+	as.mu.Lock()
+	if as.userID != "" {
+		removePreference(as.prefs, as.cacheKey())
+	}
+	as.userID = ""
+	as.addresses = nil
+	as.mu.Unlock()
+	as.notify()
+}
+
+// OnChanged registers a callback run on the UI thread whenever addresses
+// change. Call the returned function to remove it.
+func (as *AddressStore) OnChanged(listener func()) (remove func()) {
+	as.mu.Lock()
+	defer as.mu.Unlock()
+	id := as.listeners.add(listener)
+	return func() {
+		as.mu.Lock()
+		defer as.mu.Unlock()
+		as.listeners.remove(id)
+	}
+}
+
+// List returns a copy of the saved addresses
+func (as *AddressStore) List() []Address {
+	as.mu.Lock()
+	defer as.mu.Unlock()
+	return append([]Address(nil), as.addresses...)
+}
+
+// Get returns the saved address with the given ID
+func (as *AddressStore) Get(id string) (Address, bool) {
+	as.mu.Lock()
+	defer as.mu.Unlock()
+	if i := as.indexOf(id); i >= 0 {
+		return as.addresses[i], true
+	}
+	return Address{}, false
+}
+
+// Sync refreshes the list from the server.
+// onDone is called on the UI thread and receives nil on success.
+func (as *AddressStore) Sync(onDone func(error)) {
+	go func() {
+		var addresses []Address
+		err := APIRequestJSON(as.config, http.MethodGet, "/addresses", nil, &addresses)
+		if err == nil {
+			as.mu.Lock()
+			as.addresses = addresses
+			as.saveCache()
+			as.mu.Unlock()
+			as.notify()
+		}
+		if onDone != nil {
+			fyne.Do(func() { onDone(err) })
+		}
+	}()
+}
+
+// Save creates or updates an address.
+// onDone is called on the UI thread with the stored address.
+func (as *AddressStore) Save(address Address, onDone func(Address, error)) {
+	go func() {
+		var saved Address
+		var err error
+		if address.ID == "" {
+			err = APIRequestJSON(as.config, http.MethodPost, "/addresses", address, &saved)
+		} else {
+			err = APIRequestJSON(as.config, http.MethodPut, "/addresses/"+url.PathEscape(address.ID), address, &saved)
+		}
+		if err == nil {
+			as.mu.Lock()
+			if i := as.indexOf(saved.ID); i >= 0 {
+				as.addresses[i] = saved
+			} else {
+				as.addresses = append(as.addresses, saved)
+			}
+			as.saveCache()
+			as.mu.Unlock()
+			as.notify()
+		}
+		if onDone != nil {
+			fyne.Do(func() { onDone(saved, err) })
+		}
+	}()
+}
+
+// Delete removes an address.
+// onDone is called on the UI thread and receives nil on success.
+func (as *AddressStore) Delete(id string, onDone func(error)) {
+	go func() {
+		err := APIRequestJSON(as.config, http.MethodDelete, "/addresses/"+url.PathEscape(id), nil, nil)
+		if err == nil {
+			as.mu.Lock()
+			if i := as.indexOf(id); i >= 0 {
+				as.addresses = append(as.addresses[:i], as.addresses[i+1:]...)
+			}
+			as.saveCache()
+			as.mu.Unlock()
+			as.notify()
+		}
+		if onDone != nil {
+			fyne.Do(func() { onDone(err) })
+		}
+	}()
+}
+
+func (as *AddressStore) notify() {
+	as.mu.Lock()
+	listeners := as.listeners.snapshot()
+	as.mu.Unlock()
+
+	fyne.Do(func() {
+		for _, listener := range listeners {
+			listener()
+		}
+	})
+}
+
+// indexOf returns the position of an address in the list, caller must hold mu
+func (as *AddressStore) indexOf(id string) int {
+	for i, a := range as.addresses {
+		if a.ID == id {
+			return i
+		}
+	}
+	return -1
+}
+
+// removePreference deletes a cached value. It is emptied first, as
+// RemoveValue is not supported on iOS.
+func removePreference(prefs fyne.Preferences, key string) {
+	if prefs.String(key) != "" {
+		prefs.SetString(key, "")
+	}
+	prefs.RemoveValue(key)
+}
+
+// cacheKey returns the preference key of the user's cache, caller must hold mu
+func (as *AddressStore) cacheKey() string {
+	return prefAddressesCache + "." + as.userID
+}
+
+// saveCache writes the list to preferences, caller must hold mu.
+// Nothing is cached until the user is known.
+func (as *AddressStore) saveCache() {
+	if as.userID == "" {
+		return
+	}
+	if data, err := json.Marshal(as.addresses); err == nil {
+		as.prefs.SetString(as.cacheKey(), string(data))
+	}
+}
@@ -0,0 +1,259 @@
+package ui
+
+import (
+	"strings"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/dialog"
+	"fyne.io/fyne/v2/widget"
+
+	"skillDar/pkg/i18n"
+)
+
+// CreateAddressesScreen builds the client's saved addresses book
+func CreateAddressesScreen(state AppState) fyne.CanvasObject {
+	addresses := state.Addresses()
+
+	title := newLabel(i18n.T("Saved Addresses"))
+	title.Alignment = fyne.TextAlignCenter
+	title.TextStyle = fyne.TextStyle{Bold: true}
+
+	statusLabel := newLabel("")
+	statusLabel.Alignment = fyne.TextAlignCenter
+	statusLabel.Wrapping = fyne.TextWrapWord
+
+	listContainer := container.NewVBox()
+
+	refreshList := func() {
+		listContainer.Objects = nil
+		list := addresses.List()
+		if len(list) == 0 {
+			empty := newLabel(i18n.T("No saved addresses yet.\nAdd your home or work to book in one tap."))
+			empty.Alignment = fyne.TextAlignCenter
+			listContainer.Add(empty)
+		}
+		for _, address := range list {
+			a := address
+			name := newLabel("📍 " + a.Label)
+			name.TextStyle = fyne.TextStyle{Bold: true}
+			line := newLabel(a.Line())
+			line.Wrapping = fyne.TextWrapWord
+			access := newLabel(a.Access())
+			access.Wrapping = fyne.TextWrapWord
+			access.Importance = widget.LowImportance
+			if a.Access() == "" {
+				access.Hide()
+			}
+
+			editBtn := widget.NewButton(i18n.T("Edit"), func() {
+				showAddressEditor(state, a, nil)
+			})
+			deleteBtn := widget.NewButton(i18n.T("Delete"), func() {
+				dialog.ShowConfirm(i18n.T("Delete address?"), i18n.T("Remove \"%s\" from your saved addresses?", a.Label), func(ok bool) {
+					if !ok {
+						return
+					}
+					addresses.Delete(a.ID, func(err error) {
+						if err != nil {
+							state.ShowConnectionError(StatusForError(err))
+						}
+					})
+				}, state.GetWindow())
+			})
+			deleteBtn.Importance = widget.DangerImportance
+
+			listContainer.Add(container.NewVBox(
+				name,
+				line,
+				access,
+				container.NewGridWithColumns(2, editBtn, deleteBtn),
+				widget.NewSeparator(),
+			))
+		}
+		listContainer.Refresh()
+	}
+
+	addBtn := widget.NewButton(i18n.T("+ Add Address"), func() {
+		showAddressEditor(state, Address{}, nil)
+	})
+	addBtn.Importance = widget.HighImportance
+
+	removeListener := addresses.OnChanged(refreshList)
+	refreshList()
+
+	screen := NewScreen(container.NewBorder(
+		container.NewVBox(title, statusLabel),
+		addBtn,
+		nil,
+		nil,
+		container.NewVScroll(listContainer),
+	))
+	screen.ShowFunc = func() {
+		addresses.Sync(func(err error) {
+			if err != nil {
+				// Keep showing the cached list while offline
+				_, message := StatusForError(err)
+				statusLabel.SetText(i18n.T("Showing saved copy (%s)", message))
+				return
+			}
+			statusLabel.SetText("")
+		})
+	}
+	screen.DestroyFunc = removeListener
+	return screen
+}
+
+// showAddressEditor adds or edits a saved address in a dialog.
+// onSaved, if set, receives the stored address.
+func showAddressEditor(state AppState, address Address, onSaved func(Address)) {
+	w := state.GetWindow()
+
+	// Inline error labels per field
+	errorLabels := make(map[string]*widget.Label)
+	errorLabel := func(field string) *widget.Label {
+		label := newLabel("")
+		label.Importance = widget.DangerImportance
+		label.Wrapping = fyne.TextWrapWord
+		label.Hide()
+		errorLabels[field] = label
+		return label
+	}
+	showErrors := func(errs map[string]string) {
+		for field, label := range errorLabels {
+			if msg, ok := errs[field]; ok {
+				label.SetText(msg)
+				label.Show()
+			} else {
+				label.Hide()
+			}
+		}
+	}
+
+	labelEntry := widget.NewSelectEntry(translatedAddressLabels())
+	labelEntry.SetPlaceHolder(i18n.T("Name, e.g. Home"))
+	labelEntry.SetText(address.Label)
+
+	streetEntry := widget.NewEntry()
+	streetEntry.SetPlaceHolder(i18n.T("Street and number"))
+	streetEntry.SetText(address.Street)
+
+	buildingEntry := widget.NewEntry()
+	buildingEntry.SetPlaceHolder(i18n.T("Building / residence (optional)"))
+	buildingEntry.SetText(address.Building)
+
+	postalEntry := widget.NewEntry()
+	postalEntry.SetPlaceHolder(i18n.T("Postal code"))
+	postalEntry.SetText(address.PostalCode)
+
+	cityEntry := widget.NewEntry()
+	cityEntry.SetPlaceHolder(i18n.T("City"))
+	cityEntry.SetText(address.City)
+
+	floorEntry := widget.NewEntry()
+	floorEntry.SetPlaceHolder(i18n.T("Floor"))
+	floorEntry.SetText(address.Floor)
+
+	doorCodeEntry := widget.NewEntry()
+	doorCodeEntry.SetPlaceHolder(i18n.T("Door code"))
+	doorCodeEntry.SetText(address.DoorCode)
+
+	notesEntry := widget.NewMultiLineEntry()
+	notesEntry.SetPlaceHolder(i18n.T("Access notes, e.g. ring twice, blue gate"))
+	notesEntry.SetMinRowsVisible(2)
+	notesEntry.SetText(address.AccessNotes)
+
+	center := DefaultMapCenter
+	if address.Location != nil {
+		center = *address.Location
+	}
+	picker := NewLocationPicker(center, 15)
+	if address.Location != nil {
+		picker.SetPin(*address.Location)
+	}
+
+	statusLabel := newLabel("")
+	statusLabel.Wrapping = fyne.TextWrapWord
+
+	form := container.NewVBox(
+		labelEntry,
+		errorLabel("label"),
+		streetEntry,
+		errorLabel("street"),
+		buildingEntry,
+		container.NewGridWithColumns(2, postalEntry, cityEntry),
+		errorLabel("postal_code"),
+		errorLabel("city"),
+		container.NewGridWithColumns(2, floorEntry, doorCodeEntry),
+		notesEntry,
+		newLabel(i18n.T("Tap the map where the entrance is")),
+		picker,
+		errorLabel("location"),
+		statusLabel,
+	)
+
+	title := i18n.T("Add Address")
+	if address.ID != "" {
+		title = i18n.T("Edit Address")
+	}
+
+	var editor *dialog.CustomDialog
+	var saveBtn *widget.Button
+	saveBtn = widget.NewButton(i18n.T("Save"), func() {
+		edited := address
+		edited.Label = strings.TrimSpace(labelEntry.Text)
+		edited.Street = strings.TrimSpace(streetEntry.Text)
+		edited.Building = strings.TrimSpace(buildingEntry.Text)
+		edited.PostalCode = strings.TrimSpace(postalEntry.Text)
+		edited.City = strings.TrimSpace(cityEntry.Text)
+		edited.Floor = strings.TrimSpace(floorEntry.Text)
+		edited.DoorCode = strings.TrimSpace(doorCodeEntry.Text)
+		edited.AccessNotes = strings.TrimSpace(notesEntry.Text)
+		edited.Location = nil
+		if pin, ok := picker.Pin(); ok {
+			edited.Location = &pin
+		}
+
+		errs := ValidateAddress(edited)
+		showErrors(errs)
+		if len(errs) > 0 {
+			statusLabel.SetText(i18n.T("Please fix the highlighted fields"))
+			return
+		}
+
+		saveBtn.Disable()
+		statusLabel.SetText(i18n.T("Saving..."))
+		state.Addresses().Save(edited, func(saved Address, err error) {
+			saveBtn.Enable()
+			if err != nil {
+				statusLabel.SetText("")
+				state.ShowConnectionError(StatusForError(err))
+				return
+			}
+			editor.Hide()
+			if onSaved != nil {
+				onSaved(saved)
+			}
+		})
+	})
+	saveBtn.Importance = widget.HighImportance
+	cancelBtn := widget.NewButton(i18n.T("Cancel"), func() { editor.Hide() })
+
+	editor = dialog.NewCustomWithoutButtons(title, container.NewBorder(
+		nil,
+		container.NewGridWithColumns(2, cancelBtn, saveBtn),
+		nil, nil,
+		container.NewVScroll(form),
+	), w)
+	editor.Resize(w.Canvas().Size())
+	editor.Show()
+}
+
+// translatedAddressLabels returns the suggested address names in the app language
+func translatedAddressLabels() []string {
+	labels := make([]string, len(addressLabels))
+	for i, label := range addressLabels {
+		labels[i] = i18n.T(label)
+	}
+	return labels
+}
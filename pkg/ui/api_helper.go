@@ -1,9 +1,16 @@
 package ui
 
 import (
+	"bytes"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
+	"mime/multipart"
 	"net/http"
 	"time"
+
+	"skillDar/pkg/i18n"
 )
 
 // APIConfig holds API configuration
@@ -37,21 +44,21 @@ func CheckConnection(config *APIConfig) (bool, ConnectionStatus, string) {
 	if err != nil {
 		// Check if it's a network error or timeout
 		if netErr, ok := err.(interface{ Timeout() bool }); ok && netErr.Timeout() {
-			return false, StatusSlowConnection, "Connection timeout - slow network"
+			return false, StatusSlowConnection, i18n.T("Connection timeout - slow network")
 		}
-		return false, StatusNoInternet, "No internet connection"
+		return false, StatusNoInternet, i18n.T("No internet connection")
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode >= 500 {
-		return false, StatusServerDown, "Server is currently down"
+		return false, StatusServerDown, i18n.T("Server is currently down")
 	}
 
 	if resp.StatusCode >= 400 {
-		return false, StatusServerDown, fmt.Sprintf("Server error: %d", resp.StatusCode)
+		return false, StatusServerDown, i18n.T("Server error: %d", resp.StatusCode)
 	}
 
-	return true, StatusConnected, "Connected"
+	return true, StatusConnected, i18n.T("Connected")
 }
 
 // PeriodicConnectionCheck runs a periodic connection check
@@ -81,7 +88,7 @@ func APICall(method, url string, onError func(ConnectionStatus, string)) error {
 	req, err := http.NewRequest(method, url, nil)
 	if err != nil {
 		if onError != nil {
-			onError(StatusNoInternet, "Failed to create request")
+			onError(StatusNoInternet, i18n.T("Failed to create request"))
 		}
 		return err
 	}
@@ -90,11 +97,11 @@ func APICall(method, url string, onError func(ConnectionStatus, string)) error {
 	if err != nil {
 		if netErr, ok := err.(interface{ Timeout() bool }); ok && netErr.Timeout() {
 			if onError != nil {
-				onError(StatusSlowConnection, "Request timeout")
+				onError(StatusSlowConnection, i18n.T("Request timeout"))
 			}
 		} else {
 			if onError != nil {
-				onError(StatusNoInternet, "No internet connection")
+				onError(StatusNoInternet, i18n.T("No internet connection"))
 			}
 		}
 		return err
@@ -103,10 +110,164 @@ func APICall(method, url string, onError func(ConnectionStatus, string)) error {
 
 	if resp.StatusCode >= 500 {
 		if onError != nil {
-			onError(StatusServerDown, "Server error")
+			onError(StatusServerDown, i18n.T("Server error"))
 		}
 		return fmt.Errorf("server error: %d", resp.StatusCode)
 	}
 
 	return nil
 }
+
+// APIError describes a non-successful response returned by the API
+type APIError struct {
+	StatusCode int
+	Code       string // Machine-readable reason, e.g. "promo_expired"
+	Message    string
+	Fields     map[string]string // Per-field validation messages, keyed by JSON field name
+}
+
+func (e *APIError) Error() string {
+	if e.Message != "" {
+		return fmt.Sprintf("api error %d: %s", e.StatusCode, e.Message)
+	}
+	return fmt.Sprintf("api error %d", e.StatusCode)
+}
+
+// APIRequestJSON sends a JSON request to the API and decodes the JSON response into out.
+// body and out may be nil. Non-2xx responses are returned as *APIError.
+func APIRequestJSON(config *APIConfig, method, path string, body, out any) error {
+	client := &http.Client{
+		Timeout: config.Timeout,
+	}
+
+	var reader io.Reader
+	if body != nil {
+		payload, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reader = bytes.NewReader(payload)
+	}
+
+	req, err := http.NewRequest(method, config.BaseURL+path, reader)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		apiErr := &APIError{StatusCode: resp.StatusCode}
+		var errBody struct {
+			Code    string            `json:"code"`
+			Message string            `json:"message"`
+			Fields  map[string]string `json:"fields"`
+		}
+		if json.NewDecoder(resp.Body).Decode(&errBody) == nil {
+			apiErr.Code = errBody.Code
+			apiErr.Message = errBody.Message
+			apiErr.Fields = errBody.Fields
+		}
+		return apiErr
+	}
+
+	if out == nil || resp.StatusCode == http.StatusNoContent {
+		return nil
+	}
+	return json.NewDecoder(resp.Body).Decode(out)
+}
+
+// StatusForError maps an API call error to a connection status and a user-facing message
+func StatusForError(err error) (ConnectionStatus, string) {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		if apiErr.StatusCode >= 500 {
+			return StatusServerDown, i18n.T("Server is currently down")
+		}
+		if apiErr.Message != "" {
+			return StatusServerDown, apiErr.Message
+		}
+		return StatusServerDown, i18n.T("Server error: %d", apiErr.StatusCode)
+	}
+	if netErr, ok := err.(interface{ Timeout() bool }); ok && netErr.Timeout() {
+		return StatusSlowConnection, i18n.T("Request timeout")
+	}
+	return StatusNoInternet, i18n.T("No internet connection")
+}
+
+// APIUploadFile uploads a file as multipart form data and decodes the JSON response into out
+func APIUploadFile(config *APIConfig, path, field, filename string, content io.Reader, out any) error {
+	return APIUploadFileWithProgress(config, path, field, filename, content, nil, out)
+}
+
+// progressReader reports how much of a request body has been sent
+type progressReader struct {
+	reader     io.Reader
+	sent       int64
+	total      int64
+	onProgress func(sent, total int64)
+}
+
+func (p *progressReader) Read(b []byte) (int, error) {
+	n, err := p.reader.Read(b)
+	p.sent += int64(n)
+	p.onProgress(p.sent, p.total)
+	return n, err
+}
+
+// APIUploadFileWithProgress is APIUploadFile calling onProgress, from the
+// upload goroutine, as the request body is sent. onProgress may be nil.
+func APIUploadFileWithProgress(config *APIConfig, path, field, filename string, content io.Reader, onProgress func(sent, total int64), out any) error {
+	body := &bytes.Buffer{}
+	form := multipart.NewWriter(body)
+	part, err := form.CreateFormFile(field, filename)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(part, content); err != nil {
+		return err
+	}
+	if err := form.Close(); err != nil {
+		return err
+	}
+
+	client := &http.Client{
+		// Uploads may take longer than regular calls on mobile networks
+		Timeout: 6 * config.Timeout,
+	}
+
+	var reader io.Reader = body
+	size := int64(body.Len())
+	if onProgress != nil {
+		reader = &progressReader{reader: body, total: size, onProgress: onProgress}
+	}
+	req, err := http.NewRequest(http.MethodPost, config.BaseURL+path, reader)
+	if err != nil {
+		return err
+	}
+	req.ContentLength = size
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Content-Type", form.FormDataContentType())
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return &APIError{StatusCode: resp.StatusCode}
+	}
+	if out == nil {
+		return nil
+	}
+	return json.NewDecoder(resp.Body).Decode(out)
+}
@@ -0,0 +1,294 @@
+package ui
+
+import (
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/widget"
+	xwidget "fyne.io/x/fyne/widget"
+
+	"skillDar/pkg/i18n"
+	"skillDar/pkg/schedule"
+)
+
+// WorkerAvailability is a worker's booking calendar as returned by the API
+type WorkerAvailability struct {
+	Schedule schedule.Schedule  `json:"schedule"`
+	Bookings []schedule.Booking `json:"bookings"`
+}
+
+// FetchWorkerAvailability returns the schedule and existing bookings of a worker
+func FetchWorkerAvailability(config *APIConfig, workerID string) (WorkerAvailability, error) {
+	var availability WorkerAvailability
+	err := APIRequestJSON(config, http.MethodGet, "/workers/"+url.PathEscape(workerID)+"/availability", nil, &availability)
+	return availability, err
+}
+
+// FetchMySchedule returns the signed-in worker's schedule
+func FetchMySchedule(config *APIConfig) (schedule.Schedule, error) {
+	var s schedule.Schedule
+	err := APIRequestJSON(config, http.MethodGet, "/worker/schedule", nil, &s)
+	return s, err
+}
+
+// SaveMySchedule stores the signed-in worker's schedule
+func SaveMySchedule(config *APIConfig, s schedule.Schedule) error {
+	return APIRequestJSON(config, http.MethodPut, "/worker/schedule", s, nil)
+}
+
+// clockOptions returns half-hour steps between 06:00 and 23:00 for time pickers
+func clockOptions() []string {
+	var options []string
+	for c := schedule.NewClock(6, 0); c <= schedule.NewClock(23, 0); c += 30 {
+		options = append(options, c.String())
+	}
+	return options
+}
+
+// dayEditor edits the working hours of a single weekday
+type dayEditor struct {
+	day     time.Weekday
+	enabled *widget.Check
+	start   *widget.Select
+	end     *widget.Select
+}
+
+func newDayEditor(day time.Weekday) *dayEditor {
+	d := &dayEditor{
+		day:   day,
+		start: widget.NewSelect(clockOptions(), nil),
+		end:   widget.NewSelect(clockOptions(), nil),
+	}
+	d.start.SetSelected("08:00")
+	d.end.SetSelected("17:00")
+	d.enabled = widget.NewCheck(i18n.ShortDayName(day), func(on bool) {
+		if on {
+			d.start.Enable()
+			d.end.Enable()
+		} else {
+			d.start.Disable()
+			d.end.Disable()
+		}
+	})
+	d.enabled.SetChecked(day != time.Sunday)
+	return d
+}
+
+func (d *dayEditor) set(ranges []schedule.Range) {
+	if len(ranges) == 0 {
+		d.enabled.SetChecked(false)
+		return
+	}
+	d.enabled.SetChecked(true)
+	d.start.SetSelected(ranges[0].Start.String())
+	d.end.SetSelected(ranges[0].End.String())
+}
+
+func (d *dayEditor) ranges() ([]schedule.Range, error) {
+	if !d.enabled.Checked {
+		return nil, nil
+	}
+	start, err := schedule.ParseClock(d.start.Selected)
+	if err != nil {
+		return nil, err
+	}
+	end, err := schedule.ParseClock(d.end.Selected)
+	if err != nil {
+		return nil, err
+	}
+	return []schedule.Range{{Start: start, End: end}}, nil
+}
+
+func (d *dayEditor) row() fyne.CanvasObject {
+	return newBorderRow(nil, nil, d.enabled, nil,
+		container.NewGridWithColumns(2, d.start, d.end))
+}
+
+// CreateAvailabilityScreen builds the worker's working hours and days off editor
+func CreateAvailabilityScreen(state AppState) fyne.CanvasObject {
+	apiConfig := DefaultAPIConfig()
+
+	title := newLabel(i18n.T("Working Hours"))
+	title.Alignment = fyne.TextAlignCenter
+	title.TextStyle = fyne.TextStyle{Bold: true}
+
+	// Weekly hours, Monday first
+	days := []time.Weekday{
+		time.Monday, time.Tuesday, time.Wednesday, time.Thursday,
+		time.Friday, time.Saturday, time.Sunday,
+	}
+	editors := make([]*dayEditor, len(days))
+	weeklyBox := container.NewVBox()
+	for i, day := range days {
+		editors[i] = newDayEditor(day)
+		weeklyBox.Add(editors[i].row())
+	}
+
+	// Buffer between jobs
+	bufferSelect := widget.NewSelect([]string{"0", "15", "30", "45", "60"}, nil)
+	bufferSelect.SetSelected("30")
+
+	// Days off
+	var daysOff []string
+	daysOffBox := container.NewVBox()
+	var refreshDaysOff func()
+	refreshDaysOff = func() {
+		sort.Strings(daysOff)
+		daysOffBox.Objects = nil
+		if len(daysOff) == 0 {
+			daysOffBox.Add(newLabel(i18n.T("No days off planned")))
+		}
+		for _, d := range daysOff {
+			day := d
+			removeBtn := widget.NewButton("✕", func() {
+				for i, other := range daysOff {
+					if other == day {
+						daysOff = append(daysOff[:i], daysOff[i+1:]...)
+						break
+					}
+				}
+				refreshDaysOff()
+			})
+			removeBtn.Importance = widget.LowImportance
+			label := day
+			if t, err := time.ParseInLocation(schedule.DateLayout, day, schedule.Location()); err == nil {
+				label = i18n.FormatDate(t, "Mon 02 Jan 2006")
+			}
+			daysOffBox.Add(newBorderRow(nil, nil, nil, removeBtn, newLabel("🏖 "+label)))
+		}
+		daysOffBox.Refresh()
+	}
+
+	calendar := xwidget.NewCalendar(time.Now(), func(t time.Time) {
+		day := t.Format(schedule.DateLayout)
+		for _, other := range daysOff {
+			if other == day {
+				return
+			}
+		}
+		daysOff = append(daysOff, day)
+		refreshDaysOff()
+	})
+
+	// Preview of the next bookable slots
+	previewLabel := newLabel("")
+	previewLabel.Wrapping = fyne.TextWrapWord
+
+	statusLabel := newLabel("")
+	statusLabel.Wrapping = fyne.TextWrapWord
+
+	// collect builds a schedule from the form
+	collect := func() (schedule.Schedule, error) {
+		s := schedule.Schedule{
+			Weekly:  make(map[time.Weekday][]schedule.Range),
+			DaysOff: append([]string(nil), daysOff...),
+		}
+		for _, editor := range editors {
+			ranges, err := editor.ranges()
+			if err != nil {
+				return s, err
+			}
+			if len(ranges) > 0 {
+				s.Weekly[editor.day] = ranges
+			}
+		}
+		s.BufferMinutes, _ = strconv.Atoi(bufferSelect.Selected)
+		return s, s.Validate()
+	}
+
+	previewBtn := widget.NewButton(i18n.T("Preview Next Slots"), func() {
+		s, err := collect()
+		if err != nil {
+			previewLabel.SetText("⚠ " + err.Error())
+			return
+		}
+		now := time.Now()
+		slots := s.Slots(now, now.AddDate(0, 0, 7), time.Hour, time.Hour, nil)
+		if len(slots) == 0 {
+			previewLabel.SetText(i18n.T("No bookable slots in the next 7 days"))
+			return
+		}
+		text := ""
+		for i, slot := range slots {
+			if i == 5 {
+				text += "… " + i18n.T("and %d more", len(slots)-5)
+				break
+			}
+			text += i18n.FormatDate(slot.Start.In(schedule.Location()), "Mon 02 Jan 15:04") + "\n"
+		}
+		previewLabel.SetText(text)
+	})
+
+	var saveBtn *widget.Button
+	saveBtn = widget.NewButton(i18n.T("Save Schedule"), func() {
+		s, err := collect()
+		if err != nil {
+			statusLabel.SetText("⚠ " + err.Error())
+			return
+		}
+		saveBtn.Disable()
+		statusLabel.SetText(i18n.T("Saving..."))
+		go func() {
+			err := SaveMySchedule(apiConfig, s)
+			fyne.Do(func() {
+				saveBtn.Enable()
+				if err != nil {
+					statusLabel.SetText("")
+					state.ShowConnectionError(StatusForError(err))
+					return
+				}
+				statusLabel.SetText("✓ " + i18n.T("Schedule saved"))
+			})
+		}()
+	})
+	saveBtn.Importance = widget.HighImportance
+
+	// Load the current schedule
+	refreshDaysOff()
+	go func() {
+		s, err := FetchMySchedule(apiConfig)
+		if err != nil {
+			return
+		}
+		fyne.Do(func() {
+			for _, editor := range editors {
+				editor.set(s.Weekly[editor.day])
+			}
+			bufferSelect.SetSelected(strconv.Itoa(s.BufferMinutes))
+			daysOff = append([]string(nil), s.DaysOff...)
+			refreshDaysOff()
+		})
+	}()
+
+	weeklyLabel := newLabel(i18n.T("Weekly hours (%s)", schedule.TimeZone))
+	weeklyLabel.TextStyle = fyne.TextStyle{Bold: true}
+	daysOffLabel := newLabel(i18n.T("Days off"))
+	daysOffLabel.TextStyle = fyne.TextStyle{Bold: true}
+	bufferLabel := newLabel(i18n.T("Buffer between jobs (minutes)"))
+	bufferLabel.TextStyle = fyne.TextStyle{Bold: true}
+
+	content := container.NewVBox(
+		title,
+		weeklyLabel,
+		weeklyBox,
+		widget.NewSeparator(),
+		bufferLabel,
+		bufferSelect,
+		widget.NewSeparator(),
+		daysOffLabel,
+		calendar,
+		daysOffBox,
+		widget.NewSeparator(),
+		previewBtn,
+		previewLabel,
+		statusLabel,
+		saveBtn,
+	)
+
+	return container.NewVScroll(content)
+}
@@ -0,0 +1,126 @@
+package ui
+
+import (
+	"errors"
+	"image"
+	_ "image/jpeg" // Decode picked JPEG pictures
+	_ "image/png"  // Decode picked PNG pictures
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/dialog"
+	"fyne.io/fyne/v2/storage"
+	"fyne.io/fyne/v2/widget"
+
+	"skillDar/pkg/i18n"
+	"skillDar/pkg/images"
+)
+
+const (
+	avatarMaxSide  = 512        // Uploaded avatars are at most this many pixels wide
+	avatarMaxBytes = 300 * 1024 // and at most this large once encoded
+)
+
+// ShowAvatarPicker lets the user pick a picture, crop it and upload it as
+// their profile picture. On phones the system picker offers the camera
+// and the gallery.
+func ShowAvatarPicker(state AppState) {
+	w := state.GetWindow()
+	open := dialog.NewFileOpen(func(reader fyne.URIReadCloser, err error) {
+		if err != nil {
+			dialog.ShowError(err, w)
+			return
+		}
+		if reader == nil {
+			return // Cancelled
+		}
+		defer reader.Close()
+
+		img, _, err := image.Decode(reader)
+		if err != nil {
+			dialog.ShowInformation(i18n.T("Unsupported picture"), i18n.T("Please choose a JPEG or PNG picture."), w)
+			return
+		}
+		showAvatarCrop(state, img)
+	}, w)
+
+	if fyne.CurrentDevice().IsMobile() {
+		open.SetFilter(storage.NewMimeTypeFileFilter([]string{"image/*"}))
+		open.SetConfirmText(i18n.T("Take or choose photo"))
+	} else {
+		open.SetFilter(storage.NewExtensionFileFilter([]string{".jpg", ".jpeg", ".png"}))
+	}
+	open.Show()
+}
+
+// showAvatarCrop lets the user frame the picked picture before uploading it
+func showAvatarCrop(state AppState, img image.Image) {
+	editor := NewCropEditor(img)
+
+	zoom := widget.NewSlider(1, maxCropZoom)
+	zoom.Step = 0.1
+	zoom.OnChanged = editor.SetZoom
+
+	circle, square := i18n.T("Circle"), i18n.T("Square")
+	shape := widget.NewRadioGroup([]string{circle, square}, func(selected string) {
+		editor.SetCircle(selected != square)
+	})
+	shape.Horizontal = true
+	shape.SetSelected(circle)
+
+	content := container.NewBorder(
+		nil,
+		container.NewVBox(
+			newBorderRow(nil, nil, newLabel(i18n.T("Zoom")), nil, zoom),
+			shape,
+		),
+		nil, nil,
+		editor,
+	)
+
+	crop := dialog.NewCustomConfirm(i18n.T("Crop Profile Picture"), i18n.T("Use Picture"), i18n.T("Cancel"), content, func(ok bool) {
+		if ok {
+			uploadAvatar(state, editor.Crop())
+		}
+	}, state.GetWindow())
+	crop.Resize(fyne.NewSize(340, 460))
+	crop.Show()
+}
+
+// uploadAvatar shows the new picture right away and uploads it, going back
+// to the previous picture if the upload fails
+func uploadAvatar(state AppState, img image.Image) {
+	w := state.GetWindow()
+	profile := state.Profile()
+	profile.SetAvatar(img, "")
+
+	bar := widget.NewProgressBar()
+	progress := dialog.NewCustomWithoutButtons(i18n.T("Uploading picture..."), bar, w)
+	progress.Show()
+
+	go func() {
+		data, ext, err := images.Encode(images.Resize(img, avatarMaxSide), avatarMaxBytes)
+		var url string
+		if err == nil {
+			url, err = UploadAvatar(DefaultAPIConfig(), "avatar"+ext, data, func(sent, total int64) {
+				if total > 0 {
+					fyne.Do(func() { bar.SetValue(float64(sent) / float64(total)) })
+				}
+			})
+		}
+
+		fyne.Do(func() {
+			progress.Hide()
+			if err != nil {
+				profile.SetAvatar(nil, "")
+				if errors.Is(err, images.ErrCannotCompress) {
+					dialog.ShowError(err, w)
+					return
+				}
+				state.ShowConnectionError(StatusForError(err))
+				return
+			}
+			profile.SetAvatar(img, url)
+		})
+	}()
+}
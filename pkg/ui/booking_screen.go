@@ -0,0 +1,614 @@
+package ui
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/dialog"
+	"fyne.io/fyne/v2/widget"
+
+	"skillDar/pkg/billing"
+	"skillDar/pkg/i18n"
+	"skillDar/pkg/money"
+	"skillDar/pkg/router"
+	"skillDar/pkg/schedule"
+)
+
+// BookingRequest is the payload sent to the API when a client books a worker
+type BookingRequest struct {
+	WorkerID  string  `json:"worker_id"`
+	Date      string  `json:"date"`       // YYYY-MM-DD
+	StartTime string  `json:"start_time"` // HH:MM
+	Hours     int     `json:"hours"`
+	AddressID string  `json:"address_id,omitempty"`
+	Address   string  `json:"address"`
+	Access    string  `json:"access,omitempty"` // Floor, door code and access notes
+	Location  *LatLon `json:"location,omitempty"`
+	Notes     string  `json:"notes,omitempty"`
+
+	PaymentMethod billing.Method `json:"payment_method"`
+	PromoCode     string         `json:"promo_code,omitempty"`
+}
+
+// BookingResponse is returned by the API once an order is created
+type BookingResponse struct {
+	OrderID string      `json:"order_id"`
+	Total   money.Money `json:"total"` // Amount to pay, estimated from the hourly rate minus any promo
+}
+
+// defaultStartTimes lists start times offered when the worker has no published schedule
+func defaultStartTimes() []string {
+	return []string{
+		"08:00", "09:00", "10:00", "11:00", "12:00", "13:00",
+		"14:00", "15:00", "16:00", "17:00", "18:00",
+	}
+}
+
+// prefLastBooking stores the last booking made with a worker so it can be repeated
+const prefLastBooking = "booking.last."
+
+// sameOrder reports whether two requests book the same job, so an order
+// created for one can be paid for the other. The payment method may differ.
+func sameOrder(a, b BookingRequest) bool {
+	if (a.Location == nil) != (b.Location == nil) || a.Location != nil && *a.Location != *b.Location {
+		return false
+	}
+	a.Location, b.Location = nil, nil
+	a.PaymentMethod, b.PaymentMethod = "", ""
+	return a == b
+}
+
+// walletCheck holds the wallet balance the booking form last fetched
+type walletCheck struct {
+	balance     *money.Money // nil until fetched
+	unavailable bool         // The last fetch failed, the server checks the balance
+}
+
+// update keeps the result of fetching the wallet
+func (w *walletCheck) update(wallet billing.Wallet, err error) {
+	if err != nil {
+		w.balance, w.unavailable = nil, true
+		return
+	}
+	w.balance, w.unavailable = &wallet.Balance, false
+}
+
+// check returns a *billing.InsufficientBalanceError when the fetched
+// balance cannot cover amount, nil when it can or is not known
+func (w walletCheck) check(amount money.Money) error {
+	if w.balance == nil {
+		return nil
+	}
+	return billing.CheckBalance(*w.balance, amount)
+}
+
+// abandonOrder cancels an unpaid order the client changed before paying,
+// a new order is created for the changed booking
+func abandonOrder(config *APIConfig, orderID string) {
+	go func() {
+		if err := CancelOrder(config, orderID); err != nil {
+			fyne.LogError("Could not cancel unpaid order "+orderID, err)
+		}
+	}()
+}
+
+// CreateBookingScreen builds the booking form for a worker.
+// If the client booked this worker before, the form is pre-filled so the
+// booking can be repeated with a single confirmation.
+func CreateBookingScreen(state AppState, worker WorkerProfile) fyne.CanvasObject {
+	prefs := fyne.CurrentApp().Preferences()
+	apiConfig := DefaultAPIConfig()
+	// Cancelled when the screen is dropped, to stop waiting for a payment
+	ctx, cancel := context.WithCancel(context.Background())
+
+	title := newLabel(i18n.T("Book %s", worker.Name))
+	title.Alignment = fyne.TextAlignCenter
+	title.TextStyle = fyne.TextStyle{Bold: true}
+
+	subtitle := newLabel(worker.Profession + " • " + i18n.T("%s/hr", worker.HourlyRate.Compact(money.Locale())))
+	subtitle.Alignment = fyne.TextAlignCenter
+
+	// Next two weeks, labels map to dates in the schedule time zone
+	today := time.Now().In(schedule.Location())
+	var dateOptions []string
+	dates := make(map[string]time.Time)
+	for i := 0; i < 14; i++ {
+		day := time.Date(today.Year(), today.Month(), today.Day()+i, 0, 0, 0, 0, schedule.Location())
+		label := i18n.FormatDate(day, "Mon 02 Jan")
+		dateOptions = append(dateOptions, label)
+		dates[label] = day
+	}
+	dateSelect := widget.NewSelect(dateOptions, nil)
+	timeSelect := widget.NewSelect(defaultStartTimes(), nil)
+	hoursSelect := widget.NewSelect([]string{"2", "3", "4", "5", "6", "7", "8"}, nil)
+
+	slotsHint := newLabel("")
+	slotsHint.Wrapping = fyne.TextWrapWord
+
+	// Bookable slots come from the worker's schedule once it is loaded
+	var availability *WorkerAvailability
+	updateSlots := func() {
+		if availability == nil || dateSelect.Selected == "" {
+			return
+		}
+		day := dates[dateSelect.Selected]
+		hours, _ := strconv.Atoi(hoursSelect.Selected)
+		from := day
+		if from.Before(time.Now()) {
+			from = time.Now()
+		}
+		slots := availability.Schedule.Slots(from, day.AddDate(0, 0, 1), time.Duration(hours)*time.Hour, time.Hour, availability.Bookings)
+
+		options := make([]string, len(slots))
+		for i, slot := range slots {
+			options[i] = slot.Start.In(schedule.Location()).Format("15:04")
+		}
+		previous := timeSelect.Selected
+		timeSelect.SetOptions(options)
+		timeSelect.ClearSelected()
+		for _, option := range options {
+			if option == previous {
+				timeSelect.SetSelected(previous)
+			}
+		}
+		if len(options) == 0 {
+			slotsHint.SetText("⏰ " + i18n.T("No free slot that day, try another date or fewer hours"))
+		} else {
+			slotsHint.SetText("✅ " + i18n.N("%d free slot", "%d free slots", len(options), len(options)))
+		}
+	}
+	// Payment, the wallet balance is checked against the estimate
+	var paymentLabels []string
+	for _, option := range paymentMethodOptions {
+		paymentLabels = append(paymentLabels, paymentMethodLabel(option.method))
+	}
+	paymentSelect := widget.NewRadioGroup(paymentLabels, nil)
+
+	walletHint := newLabel("")
+	walletHint.Wrapping = fyne.TextWrapWord
+	walletHint.Hide()
+	topUpBtn := widget.NewButton("👛 "+i18n.T("Top Up Wallet"), func() {
+		state.Navigate(router.To(router.Wallet))
+	})
+	topUpBtn.Hide()
+
+	var wallet walletCheck
+	// promo is the applied promo code, nil if none
+	var promo *PromoQuote
+	subtotal := func() money.Money {
+		hours, _ := strconv.Atoi(hoursSelect.Selected)
+		return worker.HourlyRate.Mul(int64(hours))
+	}
+	estimate := func() money.Money {
+		if promo == nil {
+			return subtotal()
+		}
+		return subtotal().Sub(promo.Discount(subtotal()))
+	}
+	updateWalletHint := func() {
+		walletHint.Importance = widget.MediumImportance
+		topUpBtn.Hide()
+		switch {
+		case paymentMethodFromLabel(paymentSelect.Selected) != billing.MethodWallet:
+			walletHint.Hide()
+			return
+		case wallet.unavailable:
+			walletHint.SetText(i18n.T("Wallet balance unavailable, it will be checked when you confirm"))
+		case wallet.balance == nil:
+			walletHint.SetText(i18n.T("Checking wallet balance..."))
+		default:
+			var short *billing.InsufficientBalanceError
+			if errors.As(wallet.check(estimate()), &short) {
+				walletHint.SetText("⚠ " + i18n.T("Your balance of %s is %s short of this booking", short.Balance, short.Missing()))
+				walletHint.Importance = widget.DangerImportance
+				topUpBtn.Show()
+			} else {
+				walletHint.SetText(i18n.T("Wallet balance: %s", wallet.balance.String()))
+			}
+		}
+		walletHint.Show()
+		walletHint.Refresh()
+	}
+	paymentSelect.OnChanged = func(string) { updateWalletHint() }
+	paymentSelect.SetSelected(paymentMethodLabel(billing.MethodCash))
+
+	// loadWallet fetches the balance, again when coming back from a top-up
+	loadWallet := func() {
+		go func() {
+			result, err := FetchWallet(apiConfig)
+			fyne.Do(func() {
+				wallet.update(result, err)
+				updateWalletHint()
+			})
+		}()
+	}
+	loadWallet()
+
+	// Price breakdown, the final amount depends on the time actually worked
+	breakdownLabel := newLabel("")
+	estimateLabel := newLabel("")
+	estimateLabel.TextStyle = fyne.TextStyle{Bold: true}
+	updateEstimate := func() {
+		hours, _ := strconv.Atoi(hoursSelect.Selected)
+		locale := money.Locale()
+		breakdown := i18n.T("Subtotal (%d h × %s): %s",
+			hours, worker.HourlyRate.Compact(locale), subtotal().Format(locale))
+		if promo != nil {
+			breakdown += "\n" + i18n.T("Promo %s: −%s", promo.Code, promo.Discount(subtotal()).Format(locale))
+		}
+		breakdownLabel.SetText(breakdown)
+		estimateLabel.SetText(i18n.T("Estimated total: %s", estimate().Format(locale)))
+	}
+
+	dateSelect.OnChanged = func(string) { updateSlots() }
+	hoursSelect.OnChanged = func(string) {
+		updateSlots()
+		updateEstimate()
+		updateWalletHint()
+	}
+
+	if worker.Schedule != nil {
+		availability = &WorkerAvailability{Schedule: *worker.Schedule, Bookings: worker.Bookings}
+	}
+	dateSelect.SetSelected(dateOptions[1])
+	timeSelect.SetSelected("09:00")
+	hoursSelect.SetSelected("2")
+
+	go func() {
+		result, err := FetchWorkerAvailability(apiConfig, worker.ID)
+		if err != nil {
+			// Keep the default hours, the worker confirms the request anyway
+			return
+		}
+		fyne.Do(func() {
+			availability = &result
+			updateSlots()
+		})
+	}()
+
+	// Promo code, validated by the API against the current subtotal
+	promoEntry := widget.NewEntry()
+	promoEntry.SetPlaceHolder(i18n.T("Promo code"))
+	promoStatus := newLabel("")
+	promoStatus.Wrapping = fyne.TextWrapWord
+	promoStatus.Hide()
+
+	showPromoStatus := func(text string, importance widget.Importance) {
+		promoStatus.SetText(text)
+		promoStatus.Importance = importance
+		promoStatus.Show()
+		promoStatus.Refresh()
+	}
+	var applyPromoBtn, removePromoBtn *widget.Button
+	setPromo := func(quote *PromoQuote) {
+		promo = quote
+		if promo == nil {
+			promoEntry.Enable()
+			applyPromoBtn.Show()
+			removePromoBtn.Hide()
+		} else {
+			promoEntry.SetText(promo.Code)
+			promoEntry.Disable()
+			applyPromoBtn.Hide()
+			removePromoBtn.Show()
+		}
+		updateEstimate()
+		updateWalletHint()
+	}
+	applyPromoBtn = widget.NewButton(i18n.T("Apply"), func() {
+		code := strings.TrimSpace(promoEntry.Text)
+		if code == "" {
+			showPromoStatus(i18n.T("Enter a promo code"), widget.DangerImportance)
+			return
+		}
+		applyPromoBtn.Disable()
+		go func() {
+			quote, err := ValidatePromoCode(apiConfig, code, worker.ID, subtotal())
+			fyne.Do(func() {
+				applyPromoBtn.Enable()
+				if err != nil {
+					if message, ok := promoErrorMessage(err); ok {
+						showPromoStatus("⚠ "+message, widget.DangerImportance)
+					} else {
+						state.ShowConnectionError(StatusForError(err))
+					}
+					return
+				}
+				setPromo(&quote)
+				status := "✓ " + i18n.T("%s applied", quote.Code)
+				if quote.Description != "" {
+					status += ": " + quote.Description
+				}
+				if !quote.ExpiresAt.IsZero() {
+					status += " " + i18n.T("(valid until %s)", i18n.FormatDate(quote.ExpiresAt, "02 Jan"))
+				}
+				showPromoStatus(status, widget.SuccessImportance)
+			})
+		}()
+	})
+	removePromoBtn = widget.NewButton("✕", func() {
+		setPromo(nil)
+		promoEntry.SetText("")
+		promoStatus.Hide()
+	})
+	removePromoBtn.Hide()
+
+	// Where: one of the saved addresses, or a new one added on the spot
+	newAddressOption := i18n.T("+ New address...")
+	var address *Address
+	addressDetails := newLabel("")
+	addressDetails.Wrapping = fyne.TextWrapWord
+	addressDetails.Importance = widget.LowImportance
+	addressDetails.Hide()
+
+	var addressSelect *widget.Select
+	addressOptions := map[string]Address{}
+	selectAddress := func(a *Address) {
+		address = a
+		if a == nil {
+			addressSelect.ClearSelected()
+			addressDetails.Hide()
+			return
+		}
+		details := a.Line()
+		if access := a.Access(); access != "" {
+			details += "\n" + access
+		}
+		addressDetails.SetText(details)
+		addressDetails.Show()
+	}
+	refreshAddresses := func() {
+		addressOptions = map[string]Address{}
+		var options []string
+		for _, a := range state.Addresses().List() {
+			option := a.Label
+			if _, taken := addressOptions[option]; taken {
+				option += " – " + a.Line()
+			}
+			addressOptions[option] = a
+			options = append(options, option)
+		}
+		addressSelect.SetOptions(append(options, newAddressOption))
+
+		// Keep the chosen address, with its latest details
+		if address != nil {
+			for option, a := range addressOptions {
+				if a.ID == address.ID {
+					addressSelect.SetSelected(option)
+					return
+				}
+			}
+			selectAddress(nil)
+		}
+	}
+	addressSelect = widget.NewSelect(nil, func(option string) {
+		if option == newAddressOption {
+			showAddressEditor(state, Address{}, func(saved Address) {
+				address = &saved
+				refreshAddresses()
+			})
+			// Keep showing the previous choice until the new address is saved
+			if address != nil {
+				refreshAddresses()
+			} else {
+				addressSelect.ClearSelected()
+			}
+			return
+		}
+		if a, ok := addressOptions[option]; ok {
+			selectAddress(&a)
+		}
+	})
+	addressSelect.PlaceHolder = i18n.T("Choose an address")
+	refreshAddresses()
+	removeAddressListener := state.Addresses().OnChanged(refreshAddresses)
+
+	notesEntry := widget.NewMultiLineEntry()
+	notesEntry.SetPlaceHolder(i18n.T("Describe the job (optional)"))
+	notesEntry.SetMinRowsVisible(3)
+
+	// Pre-fill from the last booking with this worker
+	if data := prefs.String(prefLastBooking + worker.ID); data != "" {
+		var last BookingRequest
+		if json.Unmarshal([]byte(data), &last) == nil {
+			timeSelect.SetSelected(last.StartTime)
+			hoursSelect.SetSelected(strconv.Itoa(last.Hours))
+			if a, ok := state.Addresses().Get(last.AddressID); ok {
+				address = &a
+				refreshAddresses()
+			}
+			notesEntry.SetText(last.Notes)
+			if last.PaymentMethod != "" {
+				paymentSelect.SetSelected(paymentMethodLabel(last.PaymentMethod))
+			}
+		}
+	}
+
+	statusLabel := newLabel("")
+	statusLabel.Wrapping = fyne.TextWrapWord
+
+	providers := PaymentProviders(apiConfig)
+	// The order is kept once created so a failed payment can be retried
+	// without booking the worker twice, as long as the booking is unchanged
+	var booked *BookingResponse
+	var bookedReq BookingRequest
+
+	var confirmBtn *widget.Button
+	confirmBtn = widget.NewButton(i18n.T("Confirm & Pay"), func() {
+		hours, _ := strconv.Atoi(hoursSelect.Selected)
+		req := BookingRequest{
+			WorkerID:      worker.ID,
+			Date:          dates[dateSelect.Selected].Format("2006-01-02"),
+			StartTime:     timeSelect.Selected,
+			Hours:         hours,
+			Notes:         notesEntry.Text,
+			PaymentMethod: paymentMethodFromLabel(paymentSelect.Selected),
+		}
+		if address != nil {
+			req.AddressID = address.ID
+			req.Address = address.Line()
+			req.Access = address.Access()
+			req.Location = address.Location
+		}
+		if promo != nil {
+			req.PromoCode = promo.Code
+		}
+		if booked != nil && !sameOrder(bookedReq, req) {
+			abandonOrder(apiConfig, booked.OrderID)
+			booked = nil
+		}
+		if promo != nil && !promo.ExpiresAt.IsZero() && time.Now().After(promo.ExpiresAt) && booked == nil {
+			setPromo(nil)
+			showPromoStatus("⚠ "+i18n.T("This promo code has expired"), widget.DangerImportance)
+			return
+		}
+
+		if dateSelect.Selected == "" || req.StartTime == "" {
+			statusLabel.SetText(i18n.T("Please pick a date and a start time"))
+			return
+		}
+		if req.Address == "" {
+			statusLabel.SetText(i18n.T("Please choose the address of the job"))
+			return
+		}
+		provider, err := providers.Get(req.PaymentMethod)
+		if err != nil {
+			statusLabel.SetText(i18n.T("Please choose a payment method"))
+			return
+		}
+		if req.PaymentMethod == billing.MethodWallet && booked == nil && wallet.check(estimate()) != nil {
+			statusLabel.SetText(i18n.T("Top up your wallet or choose another payment method"))
+			return
+		}
+
+		confirmBtn.Disable()
+		statusLabel.SetText(i18n.T("Sending booking..."))
+
+		order := booked
+		go func() {
+			if order == nil {
+				var resp BookingResponse
+				if err := APIRequestJSON(apiConfig, http.MethodPost, "/orders", req, &resp); err != nil {
+					if ctx.Err() != nil {
+						return
+					}
+					fyne.Do(func() {
+						confirmBtn.Enable()
+						statusLabel.SetText("")
+						// The code may have expired or run out since it was applied
+						if message, ok := promoErrorMessage(err); ok && req.PromoCode != "" {
+							setPromo(nil)
+							showPromoStatus("⚠ "+message, widget.DangerImportance)
+							return
+						}
+						state.ShowConnectionError(StatusForError(err))
+					})
+					return
+				}
+				if resp.Total.IsZero() {
+					resp.Total = estimate()
+				}
+				order = &resp
+				fyne.Do(func() { booked, bookedReq = order, req })
+			}
+
+			checkout := billing.Checkout{
+				Provider: provider,
+				OpenURL:  openCheckoutURL,
+				OnStatus: func(p billing.Payment) {
+					fyne.Do(func() { statusLabel.SetText(paymentStatusText(p)) })
+				},
+			}
+			payment, err := checkout.PayAndConfirm(ctx, order.OrderID, order.Total)
+			if ctx.Err() != nil {
+				return // The screen was closed
+			}
+
+			var receipt *billing.Receipt
+			if err == nil && payment.Status == billing.StatusPaid {
+				if r, err := provider.Receipt(payment.ID); err == nil {
+					receipt = &r
+				}
+			}
+
+			fyne.Do(func() {
+				confirmBtn.Enable()
+				if err != nil {
+					if payment.ID != "" && !payment.Status.Confirmed() {
+						statusLabel.SetText(paymentStatusText(payment) + "\n" + i18n.T("Choose another payment method or try again."))
+						if payment.Reason == billing.ReasonInsufficientBalance {
+							topUpBtn.Show()
+						}
+					} else {
+						statusLabel.SetText("")
+						state.ShowConnectionError(StatusForError(err))
+					}
+					return
+				}
+
+				// The address is found again by its ID, so the door code
+				// is not kept outside the address cache
+				last := req
+				last.Address, last.Access, last.Location = "", "", nil
+				if data, err := json.Marshal(last); err == nil {
+					prefs.SetString(prefLastBooking+worker.ID, string(data))
+				}
+				state.HideConnectionError()
+
+				message := i18n.T("Your booking with %s is confirmed.", worker.Name) + "\n" + paymentStatusText(payment)
+				if receipt != nil {
+					message += "\n\n" + formatReceipt(*receipt)
+				}
+				dialog.ShowInformation(i18n.T("Booking Confirmed"), message, state.GetWindow())
+				state.ResetTo(router.To(router.Main))
+			})
+		}()
+	})
+	confirmBtn.Importance = widget.HighImportance
+
+	content := container.NewVBox(
+		title,
+		subtitle,
+		widget.NewSeparator(),
+		newLabel(i18n.T("When")),
+		dateSelect,
+		container.NewGridWithColumns(2, timeSelect, hoursSelect),
+		slotsHint,
+		newLabel(i18n.T("Where")),
+		addressSelect,
+		addressDetails,
+		newLabel(i18n.T("Details")),
+		notesEntry,
+		widget.NewSeparator(),
+		newLabel(i18n.T("Promo Code")),
+		newBorderRow(nil, nil, nil, newRow(applyPromoBtn, removePromoBtn), promoEntry),
+		promoStatus,
+		breakdownLabel,
+		estimateLabel,
+		newLabel(i18n.T("Payment")),
+		paymentSelect,
+		walletHint,
+		topUpBtn,
+		statusLabel,
+		confirmBtn,
+	)
+
+	screen := NewScreen(container.NewVScroll(content))
+	screen.ShowFunc = func() {
+		if screen.Revisited() {
+			loadWallet()
+		}
+	}
+	screen.DestroyFunc = func() {
+		cancel()
+		removeAddressListener()
+	}
+	return screen
+}
@@ -0,0 +1,39 @@
+package ui
+
+import (
+	"errors"
+	"testing"
+
+	"skillDar/pkg/billing"
+	"skillDar/pkg/money"
+)
+
+func TestWalletCheckTopUp(t *testing.T) {
+	price := money.Dinars(90)
+	var wallet walletCheck
+	if err := wallet.check(price); err != nil {
+		t.Errorf("balance not fetched yet: check = %v, want nil", err)
+	}
+
+	wallet.update(billing.Wallet{Balance: money.Dinars(40)}, nil)
+	var short *billing.InsufficientBalanceError
+	if err := wallet.check(price); !errors.As(err, &short) || short.Missing() != money.Dinars(50) {
+		t.Fatalf("40 dinars for 90: check = %v, want 50 dinars short", err)
+	}
+
+	// Coming back from the wallet screen fetches the topped-up balance
+	wallet.update(billing.Wallet{Balance: money.Dinars(140)}, nil)
+	if err := wallet.check(price); err != nil {
+		t.Errorf("after the top-up: check = %v, want nil", err)
+	}
+
+	// A failed fetch leaves the check to the server
+	wallet.update(billing.Wallet{}, errors.New("offline"))
+	if err := wallet.check(price); err != nil || !wallet.unavailable {
+		t.Errorf("failed fetch: check = %v unavailable %v, want nil and true", err, wallet.unavailable)
+	}
+	wallet.update(billing.Wallet{Balance: money.Dinars(10)}, nil)
+	if wallet.unavailable || wallet.check(price) == nil {
+		t.Error("a later fetch must block a short balance again")
+	}
+}
@@ -0,0 +1,173 @@
+package ui
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/widget"
+
+	"skillDar/pkg/i18n"
+)
+
+// How often an open conversation checks for new messages, normally and in data saver mode
+const (
+	chatPollInterval          = 5 * time.Second
+	chatPollIntervalDataSaver = 30 * time.Second
+)
+
+// ChatMessage is one message in a conversation between a client and a worker
+type ChatMessage struct {
+	ID       string    `json:"id"`
+	Sender   string    `json:"sender"`
+	FromMe   bool      `json:"from_me"`
+	Text     string    `json:"text"`
+	SentAt   time.Time `json:"sent_at"`
+	ChatWith string    `json:"chat_with,omitempty"` // Name of the other participant
+}
+
+// FetchChatMessages returns the messages of a conversation, oldest first
+func FetchChatMessages(config *APIConfig, chatID string) ([]ChatMessage, error) {
+	var messages []ChatMessage
+	path := fmt.Sprintf("/chats/%s/messages", url.PathEscape(chatID))
+	err := APIRequestJSON(config, http.MethodGet, path, nil, &messages)
+	return messages, err
+}
+
+// SendChatMessage posts a message to a conversation
+func SendChatMessage(config *APIConfig, chatID, text string) (ChatMessage, error) {
+	var message ChatMessage
+	path := fmt.Sprintf("/chats/%s/messages", url.PathEscape(chatID))
+	err := APIRequestJSON(config, http.MethodPost, path, map[string]string{"text": text}, &message)
+	return message, err
+}
+
+// createChatBubble shows one message, aligned to the trailing edge when sent by the user
+func createChatBubble(message ChatMessage) fyne.CanvasObject {
+	text := newLabel(message.Text + "\n" + i18n.FormatDate(message.SentAt, "02 Jan 15:04"))
+	text.Wrapping = fyne.TextWrapWord
+	text.Alignment = textAlignLeading()
+	if message.FromMe {
+		text.Alignment = textAlignTrailing()
+		text.Importance = widget.HighImportance
+	}
+	return text
+}
+
+// CreateChatScreen shows a conversation, opened from a link or a notification
+func CreateChatScreen(state AppState, chatID string) fyne.CanvasObject {
+	apiConfig := DefaultAPIConfig()
+
+	title := newLabel(i18n.T("Messages"))
+	title.TextStyle = fyne.TextStyle{Bold: true}
+	title.Alignment = fyne.TextAlignCenter
+
+	noMessages := newLabel(i18n.T("No messages yet"))
+	messagesBox := container.NewVBox(newLabel(i18n.T("Loading...")))
+	scroll := container.NewVScroll(container.NewPadded(messagesBox))
+
+	// shownIDs are the messages on screen, polling only adds new ones
+	shownIDs := map[string]bool{}
+	addMessage := func(message ChatMessage) {
+		if shownIDs[message.ID] {
+			return
+		}
+		shownIDs[message.ID] = true
+		messagesBox.Remove(noMessages)
+		messagesBox.Add(createChatBubble(message))
+	}
+
+	load := func(initial bool) {
+		go func() {
+			messages, err := FetchChatMessages(apiConfig, chatID)
+			fyne.Do(func() {
+				if err != nil {
+					if initial {
+						state.ShowConnectionError(StatusForError(err))
+						messagesBox.Objects = []fyne.CanvasObject{newLabel(i18n.T("This conversation could not be loaded"))}
+						messagesBox.Refresh()
+					}
+					return
+				}
+				if initial {
+					messagesBox.Objects = []fyne.CanvasObject{noMessages}
+				}
+				if len(messages) > 0 && messages[0].ChatWith != "" {
+					title.SetText(messages[0].ChatWith)
+				}
+				count := len(shownIDs)
+				for _, message := range messages {
+					addMessage(message)
+				}
+				if initial || len(shownIDs) > count {
+					messagesBox.Refresh()
+					scroll.ScrollToBottom()
+				}
+			})
+		}()
+	}
+	load(true)
+
+	input := widget.NewEntry()
+	input.SetPlaceHolder(i18n.T("Write a message..."))
+	var sendBtn *widget.Button
+	sendBtn = widget.NewButton(i18n.T("Send"), func() {
+		text := strings.TrimSpace(input.Text)
+		if text == "" {
+			return
+		}
+		sendBtn.Disable()
+		go func() {
+			message, err := SendChatMessage(apiConfig, chatID, text)
+			fyne.Do(func() {
+				sendBtn.Enable()
+				if err != nil {
+					state.ShowConnectionError(StatusForError(err))
+					return
+				}
+				input.SetText("")
+				addMessage(message)
+				scroll.ScrollToBottom()
+			})
+		}()
+	})
+	sendBtn.Importance = widget.HighImportance
+	input.OnSubmitted = func(string) { sendBtn.OnTapped() }
+
+	composer := newBorderRow(nil, nil, nil, sendBtn, input)
+
+	// New messages are polled only while the conversation is on screen
+	screen := NewScreen(container.NewBorder(title, composer, nil, nil, scroll))
+	var stop chan struct{}
+	screen.ShowFunc = func() {
+		interval := chatPollInterval
+		if state.Settings().DataSaver() {
+			interval = chatPollIntervalDataSaver
+		}
+		stop = make(chan struct{})
+		go func(stop chan struct{}) {
+			ticker := time.NewTicker(interval)
+			defer ticker.Stop()
+			for {
+				select {
+				case <-ticker.C:
+					load(false)
+				case <-stop:
+					return
+				}
+			}
+		}(stop)
+	}
+	screen.HideFunc = func() {
+		if stop != nil {
+			close(stop)
+			stop = nil
+		}
+	}
+	screen.DestroyFunc = screen.HideFunc
+	return screen
+}
@@ -0,0 +1,210 @@
+package ui
+
+import (
+	"fmt"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/dialog"
+	"fyne.io/fyne/v2/widget"
+
+	"skillDar/pkg/billing"
+	"skillDar/pkg/i18n"
+	"skillDar/pkg/router"
+)
+
+// createOrdersContent lists the client's orders on the orders tab
+func createOrdersContent(state AppState) fyne.CanvasObject {
+	apiConfig := DefaultAPIConfig()
+	providers := PaymentProviders(apiConfig)
+
+	title := newLabel(i18n.T("My Orders"))
+	title.TextStyle = fyne.TextStyle{Bold: true}
+	title.Alignment = fyne.TextAlignCenter
+
+	ordersContainer := container.NewVBox(newLabel(i18n.T("Loading...")))
+
+	var load func()
+	load = func() {
+		go func() {
+			orders, err := FetchClientOrders(apiConfig)
+			fyne.Do(func() {
+				ordersContainer.Objects = nil
+				if err != nil {
+					state.ShowConnectionError(StatusForError(err))
+				}
+				if len(orders) == 0 {
+					noOrders := newLabel(i18n.T("No orders yet"))
+					noOrders.Alignment = fyne.TextAlignCenter
+					ordersContainer.Add(noOrders)
+				}
+				for _, order := range orders {
+					ordersContainer.Add(createClientOrderCard(state, providers, order, load))
+				}
+				ordersContainer.Refresh()
+			})
+		}()
+	}
+	load()
+
+	// Orders change on other screens, e.g. after a booking
+	screen := NewScreen(container.NewBorder(title, nil, nil, nil, container.NewVScroll(ordersContainer)))
+	screen.ShowFunc = func() {
+		if screen.Revisited() {
+			load()
+		}
+	}
+	return screen
+}
+
+// createClientOrderCard shows one order with its payment, receipt and cancel actions
+func createClientOrderCard(state AppState, providers billing.Providers, order Order, onChanged func()) fyne.CanvasObject {
+	apiConfig := DefaultAPIConfig()
+
+	header := newLabel(fmt.Sprintf("%s • %s", order.WorkerName, order.Category))
+	header.TextStyle = fyne.TextStyle{Bold: true}
+	header.Truncation = fyne.TextTruncateEllipsis
+
+	details := newLabel("🗓 " + i18n.T("%s (%dh)", i18n.FormatDate(order.ScheduledAt, "Mon 02 Jan 15:04"), order.Hours) +
+		"\n📍 " + order.Address)
+	details.Wrapping = fyne.TextWrapWord
+
+	statusLabel := newLabel(order.Status.Label())
+	switch order.Status {
+	case OrderAccepted, OrderCompleted:
+		statusLabel.Importance = widget.SuccessImportance
+	case OrderDeclined, OrderCancelled:
+		statusLabel.Importance = widget.DangerImportance
+	default:
+		statusLabel.Importance = widget.WarningImportance
+	}
+
+	paymentLabel := newLabel(fmt.Sprintf("%s • %s", order.Total, paymentMethodLabel(order.PaymentMethod)))
+
+	provider, providerErr := providers.Get(order.PaymentMethod)
+
+	receiptBtn := widget.NewButton("🧾 "+i18n.T("Receipt"), func() {
+		go func() {
+			receipt, err := provider.Receipt(order.PaymentID)
+			fyne.Do(func() {
+				if err != nil {
+					dialog.ShowError(err, state.GetWindow())
+					return
+				}
+				dialog.ShowInformation(i18n.T("Receipt"), formatReceipt(receipt), state.GetWindow())
+			})
+		}()
+	})
+
+	// Cancelling is done in two steps, when the refund fails after the
+	// order was cancelled only the refund is tried again
+	var cancelBtn *widget.Button
+	cancelled := false
+	cancelAndRefund := func() {
+		cancelBtn.Disable()
+		alreadyCancelled := cancelled
+		go func() {
+			var err error
+			if !alreadyCancelled {
+				err = CancelOrder(apiConfig, order.ID)
+			}
+			orderCancelled := err == nil
+			var refund billing.Payment
+			if err == nil && order.PaymentID != "" && providerErr == nil {
+				var payment billing.Payment
+				if payment, err = provider.Status(order.PaymentID); err == nil {
+					refund, err = billing.RefundOnCancel(provider, payment)
+				}
+			}
+			fyne.Do(func() {
+				if orderCancelled && !cancelled {
+					cancelled = true
+					statusLabel.SetText(OrderCancelled.Label())
+					statusLabel.Importance = widget.DangerImportance
+					statusLabel.Refresh()
+					cancelBtn.SetText(i18n.T("Retry Refund"))
+				}
+				if err != nil {
+					cancelBtn.Enable()
+					state.ShowConnectionError(StatusForError(err))
+					return
+				}
+				if refund.Status == billing.StatusRefunded {
+					dialog.ShowInformation(i18n.T("Order Cancelled"), paymentStatusText(refund), state.GetWindow())
+				}
+				onChanged()
+			})
+		}()
+	}
+	cancelBtn = widget.NewButton(i18n.T("Cancel Order"), func() {
+		if cancelled {
+			cancelAndRefund()
+			return
+		}
+		dialog.ShowConfirm(i18n.T("Cancel Order"), i18n.T("Cancel this booking? Any payment will be refunded."), func(ok bool) {
+			if ok {
+				cancelAndRefund()
+			}
+		}, state.GetWindow())
+	})
+	cancelBtn.Importance = widget.DangerImportance
+
+	if order.Status != OrderPending && order.Status != OrderAccepted {
+		cancelBtn.Hide()
+	}
+	if order.PaymentID == "" || providerErr != nil || order.PaymentMethod == billing.MethodCash && order.Status != OrderCompleted {
+		receiptBtn.Hide()
+	}
+
+	return container.NewVBox(
+		newBorderRow(nil, nil, nil, statusLabel, header),
+		details,
+		paymentLabel,
+		newRow(receiptBtn, cancelBtn),
+		widget.NewSeparator(),
+	)
+}
+
+// CreateOrderScreen shows a single order, opened from a link or a notification
+func CreateOrderScreen(state AppState, orderID string) fyne.CanvasObject {
+	apiConfig := DefaultAPIConfig()
+	providers := PaymentProviders(apiConfig)
+
+	title := newLabel(i18n.T("Order"))
+	title.TextStyle = fyne.TextStyle{Bold: true}
+	title.Alignment = fyne.TextAlignCenter
+
+	orderContainer := container.NewVBox(newLabel(i18n.T("Loading...")))
+
+	var load func()
+	load = func() {
+		go func() {
+			order, err := FetchOrder(apiConfig, orderID)
+			fyne.Do(func() {
+				if err != nil {
+					state.ShowConnectionError(StatusForError(err))
+					orderContainer.Objects = []fyne.CanvasObject{newLabel(i18n.T("This order could not be loaded"))}
+					orderContainer.Refresh()
+					return
+				}
+				workerBtn := widget.NewButton(i18n.T("View %s", order.WorkerName), func() {
+					state.Navigate(router.WorkerProfileRoute(order.WorkerID))
+				})
+				orderContainer.Objects = []fyne.CanvasObject{
+					createClientOrderCard(state, providers, order, load),
+					workerBtn,
+				}
+				orderContainer.Refresh()
+			})
+		}()
+	}
+	load()
+
+	screen := NewScreen(container.NewBorder(title, nil, nil, nil, container.NewVScroll(container.NewPadded(orderContainer))))
+	screen.ShowFunc = func() {
+		if screen.Revisited() {
+			load()
+		}
+	}
+	return screen
+}
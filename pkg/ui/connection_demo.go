@@ -2,6 +2,8 @@ package ui
 
 import (
 	"time"
+
+	"skillDar/pkg/i18n"
 )
 
 // Example usage of connection notifications
@@ -57,20 +59,39 @@ func ExamplePeriodicMonitoring(state AppState) {
 // Example 4: Show different types of notifications
 func ExampleShowDifferentNotifications(state AppState) {
 	// No internet
-	state.ShowConnectionError(StatusNoInternet, "No internet connection. Please check your network.")
+	state.ShowConnectionError(StatusNoInternet, i18n.T("No internet connection. Please check your network."))
 
 	time.Sleep(3 * time.Second)
 
 	// Server down
-	state.ShowConnectionError(StatusServerDown, "Server is temporarily unavailable. Please try again later.")
+	state.ShowConnectionError(StatusServerDown, i18n.T("Server is temporarily unavailable. Please try again later."))
 
 	time.Sleep(3 * time.Second)
 
 	// Slow connection
-	state.ShowConnectionError(StatusSlowConnection, "Slow connection detected. Loading may take longer.")
+	state.ShowConnectionError(StatusSlowConnection, i18n.T("Slow connection detected. Loading may take longer."))
 
 	time.Sleep(3 * time.Second)
 
 	// Hide notification
 	state.HideConnectionError()
 }
+
+// Example 5: Stack notifications of different severities, with an action.
+// The promotion is left out when the user turned promotions off.
+func ExampleNotifications(state AppState, retry func()) {
+	notifications := state.Notifications()
+
+	notifications.Show(Notification{Severity: SeveritySuccess, Message: i18n.T("Profile saved")})
+	notifications.Show(Notification{
+		Severity: SeverityError,
+		Message:  i18n.T("Server error"),
+		Duration: NoAutoDismiss,
+		Action:   &NotificationAction{Label: i18n.T("Retry"), OnTapped: retry},
+	})
+	notifications.Show(Notification{
+		Severity: SeverityInfo,
+		Message:  i18n.T("Offers and promo codes"),
+		Category: NotifyPromotions,
+	})
+}
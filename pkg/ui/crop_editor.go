@@ -0,0 +1,197 @@
+package ui
+
+import (
+	"image"
+	"image/color"
+	"math"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+
+	"skillDar/pkg/images"
+)
+
+// maxCropZoom is how far the crop editor zooms in, relative to the largest square
+const maxCropZoom = 4
+
+// CropEditor lets the user pick the square or circle of a picture to keep.
+// Drag moves the selection; SetZoom makes it smaller to zoom in.
+type CropEditor struct {
+	widget.BaseWidget
+
+	img    image.Image
+	zoom   float64 // 1 selects the largest square, up to maxCropZoom
+	circle bool
+	cx, cy float64 // Center of the selection, in image pixels
+}
+
+// NewCropEditor creates an editor with the largest centered square selected
+func NewCropEditor(img image.Image) *CropEditor {
+	b := img.Bounds()
+	e := &CropEditor{
+		img:    img,
+		zoom:   1,
+		circle: true,
+		cx:     float64(b.Min.X) + float64(b.Dx())/2,
+		cy:     float64(b.Min.Y) + float64(b.Dy())/2,
+	}
+	e.ExtendBaseWidget(e)
+	return e
+}
+
+// SetZoom changes the selection size, keeping its center where possible
+func (e *CropEditor) SetZoom(zoom float64) {
+	e.zoom = math.Max(1, math.Min(maxCropZoom, zoom))
+	e.clamp()
+	e.Refresh()
+}
+
+// SetCircle switches between a circular and a square crop
+func (e *CropEditor) SetCircle(circle bool) {
+	e.circle = circle
+	e.Refresh()
+}
+
+// Crop returns the selected part of the picture, with transparent corners
+// for a circular crop
+func (e *CropEditor) Crop() image.Image {
+	out := images.CropRect(e.img, e.selection())
+	if e.circle {
+		return images.CropCircle(out)
+	}
+	return out
+}
+
+// side is the selection's side in image pixels
+func (e *CropEditor) side() float64 {
+	b := e.img.Bounds()
+	return float64(min(b.Dx(), b.Dy())) / e.zoom
+}
+
+// selection is the selected square in image pixels
+func (e *CropEditor) selection() image.Rectangle {
+	half := e.side() / 2
+	return image.Rect(
+		int(math.Round(e.cx-half)), int(math.Round(e.cy-half)),
+		int(math.Round(e.cx+half)), int(math.Round(e.cy+half)),
+	)
+}
+
+// clamp keeps the selection inside the picture
+func (e *CropEditor) clamp() {
+	b := e.img.Bounds()
+	half := e.side() / 2
+	e.cx = math.Max(float64(b.Min.X)+half, math.Min(float64(b.Max.X)-half, e.cx))
+	e.cy = math.Max(float64(b.Min.Y)+half, math.Min(float64(b.Max.Y)-half, e.cy))
+}
+
+// placement returns where the picture is drawn in the widget and its scale
+func (e *CropEditor) placement() (fyne.Position, float32) {
+	b := e.img.Bounds()
+	size := e.Size()
+	scale := float32(math.Min(float64(size.Width)/float64(b.Dx()), float64(size.Height)/float64(b.Dy())))
+	origin := fyne.NewPos((size.Width-float32(b.Dx())*scale)/2, (size.Height-float32(b.Dy())*scale)/2)
+	return origin, scale
+}
+
+// Dragged moves the selection with the pointer
+func (e *CropEditor) Dragged(ev *fyne.DragEvent) {
+	_, scale := e.placement()
+	if scale <= 0 {
+		return
+	}
+	e.cx += float64(ev.Dragged.DX / scale)
+	e.cy += float64(ev.Dragged.DY / scale)
+	e.clamp()
+	e.Refresh()
+}
+
+func (e *CropEditor) DragEnd() {}
+
+func (e *CropEditor) MinSize() fyne.Size {
+	return fyne.NewSize(260, 260)
+}
+
+func (e *CropEditor) CreateRenderer() fyne.WidgetRenderer {
+	picture := canvas.NewImageFromImage(e.img)
+	picture.FillMode = canvas.ImageFillContain
+	r := &cropEditorRenderer{
+		editor:  e,
+		picture: picture,
+		frame:   canvas.NewRectangle(color.Transparent),
+		guide:   canvas.NewCircle(color.Transparent),
+	}
+	for i := range r.shades {
+		r.shades[i] = canvas.NewRectangle(color.Transparent)
+	}
+	r.Refresh()
+	return r
+}
+
+type cropEditorRenderer struct {
+	editor  *CropEditor
+	picture *canvas.Image
+	shades  [4]*canvas.Rectangle // Darken the picture around the selection
+	frame   *canvas.Rectangle
+	guide   *canvas.Circle // Outline of a circular crop
+}
+
+func (r *cropEditorRenderer) Layout(size fyne.Size) {
+	e := r.editor
+	r.picture.Resize(size)
+
+	origin, scale := e.placement()
+	b := e.img.Bounds()
+	half := float32(e.side()) / 2
+	x := origin.X + (float32(e.cx-float64(b.Min.X))-half)*scale
+	y := origin.Y + (float32(e.cy-float64(b.Min.Y))-half)*scale
+	side := half * 2 * scale
+
+	// Above, below, left and right of the selection
+	r.shades[0].Move(fyne.NewPos(0, 0))
+	r.shades[0].Resize(fyne.NewSize(size.Width, y))
+	r.shades[1].Move(fyne.NewPos(0, y+side))
+	r.shades[1].Resize(fyne.NewSize(size.Width, size.Height-y-side))
+	r.shades[2].Move(fyne.NewPos(0, y))
+	r.shades[2].Resize(fyne.NewSize(x, side))
+	r.shades[3].Move(fyne.NewPos(x+side, y))
+	r.shades[3].Resize(fyne.NewSize(size.Width-x-side, side))
+
+	r.frame.Move(fyne.NewPos(x, y))
+	r.frame.Resize(fyne.NewSize(side, side))
+	r.guide.Move(fyne.NewPos(x, y))
+	r.guide.Resize(fyne.NewSize(side, side))
+}
+
+func (r *cropEditorRenderer) MinSize() fyne.Size {
+	return r.editor.MinSize()
+}
+
+func (r *cropEditorRenderer) Refresh() {
+	shade := color.NRGBA{A: 0x99}
+	for _, s := range r.shades {
+		s.FillColor = shade
+		s.Refresh()
+	}
+
+	r.frame.StrokeColor = theme.Color(theme.ColorNameForeground)
+	r.frame.StrokeWidth = 1
+	r.guide.StrokeColor = theme.Color(theme.ColorNamePrimary)
+	r.guide.StrokeWidth = 2
+	if r.editor.circle {
+		r.guide.Show()
+	} else {
+		r.guide.Hide()
+	}
+	r.Layout(r.editor.Size())
+	canvas.Refresh(r.frame)
+	canvas.Refresh(r.guide)
+}
+
+func (r *cropEditorRenderer) Objects() []fyne.CanvasObject {
+	return []fyne.CanvasObject{r.picture, r.shades[0], r.shades[1], r.shades[2], r.shades[3], r.frame, r.guide}
+}
+
+func (r *cropEditorRenderer) Destroy() {}
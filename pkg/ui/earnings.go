@@ -0,0 +1,176 @@
+package ui
+
+import (
+	"encoding/csv"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"skillDar/pkg/money"
+	"skillDar/pkg/schedule"
+)
+
+// DefaultCommissionRate is the platform commission in basis points (15%)
+const DefaultCommissionRate = 1500
+
+// PayoutStatus tracks whether a completed job has been paid out to the worker
+type PayoutStatus string
+
+const (
+	PayoutNone      PayoutStatus = ""          // Earned, still in the worker's balance
+	PayoutRequested PayoutStatus = "requested" // Part of a payout being processed
+	PayoutPaid      PayoutStatus = "paid"      // Transferred to the worker
+)
+
+// EarningLine is a completed job with the commission deducted
+type EarningLine struct {
+	Order      Order
+	Gross      money.Money
+	Commission money.Money
+	Net        money.Money
+}
+
+// Earnings summarizes what a worker earned
+type Earnings struct {
+	Today, Week, Month money.Money // Net earnings by completion date
+
+	Available money.Money // Not yet requested for payout
+	InPayout  money.Money // Requested, being processed
+	PaidOut   money.Money // Already transferred
+
+	Lines []EarningLine // Completed jobs, most recent first
+}
+
+// ComputeEarnings derives totals and balances from the worker's orders.
+// Periods are calendar days, ISO weeks (Monday first) and months in Africa/Tunis time.
+func ComputeEarnings(orders []Order, now time.Time) Earnings {
+	loc := schedule.Location()
+	local := now.In(loc)
+	dayStart := time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, loc)
+	weekStart := dayStart.AddDate(0, 0, -((int(dayStart.Weekday()) + 6) % 7))
+	monthStart := time.Date(local.Year(), local.Month(), 1, 0, 0, 0, 0, loc)
+
+	var e Earnings
+	for _, order := range orders {
+		if order.Status != OrderCompleted {
+			continue
+		}
+
+		rate := order.CommissionRate
+		if rate <= 0 {
+			rate = DefaultCommissionRate
+		}
+		line := EarningLine{
+			Order:      order,
+			Gross:      order.Total,
+			Commission: order.Total.Percent(rate),
+		}
+		line.Net = line.Gross.Sub(line.Commission)
+		e.Lines = append(e.Lines, line)
+
+		completed := order.completedTime()
+		if !completed.Before(dayStart) {
+			e.Today = e.Today.Add(line.Net)
+		}
+		if !completed.Before(weekStart) {
+			e.Week = e.Week.Add(line.Net)
+		}
+		if !completed.Before(monthStart) {
+			e.Month = e.Month.Add(line.Net)
+		}
+
+		switch order.PayoutStatus {
+		case PayoutPaid:
+			e.PaidOut = e.PaidOut.Add(line.Net)
+		case PayoutRequested:
+			e.InPayout = e.InPayout.Add(line.Net)
+		default:
+			e.Available = e.Available.Add(line.Net)
+		}
+	}
+
+	sort.Slice(e.Lines, func(i, j int) bool {
+		return e.Lines[i].Order.completedTime().After(e.Lines[j].Order.completedTime())
+	})
+	return e
+}
+
+// FetchWorkerEarnings loads the signed-in worker's completed jobs and summarizes them
+func FetchWorkerEarnings(config *APIConfig) (Earnings, error) {
+	orders, err := FetchWorkerJobs(config, OrderCompleted, time.Time{})
+	if err != nil {
+		return Earnings{}, err
+	}
+	return ComputeEarnings(orders, time.Now()), nil
+}
+
+// completedTime returns when the job was completed, falling back to its scheduled time
+func (o Order) completedTime() time.Time {
+	if o.CompletedAt.IsZero() {
+		return o.ScheduledAt
+	}
+	return o.CompletedAt
+}
+
+// WriteEarningsCSV writes the job history for the worker's own bookkeeping
+func WriteEarningsCSV(w io.Writer, lines []EarningLine) error {
+	out := csv.NewWriter(w)
+	header := []string{
+		"Completed", "Order", "Client", "Category", "Hours",
+		"Gross", "Commission", "Net", "Currency", "Payout",
+	}
+	if err := out.Write(header); err != nil {
+		return err
+	}
+
+	for _, line := range lines {
+		completed := line.Order.completedTime()
+		payout := string(line.Order.PayoutStatus)
+		if payout == "" {
+			payout = "pending"
+		}
+		record := []string{
+			completed.In(schedule.Location()).Format("2006-01-02 15:04"),
+			csvText(line.Order.ID),
+			csvText(line.Order.ClientName),
+			csvText(line.Order.Category),
+			strconv.Itoa(line.Order.Hours),
+			line.Gross.Decimal(),
+			line.Commission.Decimal(),
+			line.Net.Decimal(),
+			string(line.Net.Cur()),
+			payout,
+		}
+		if err := out.Write(record); err != nil {
+			return err
+		}
+	}
+
+	out.Flush()
+	return out.Error()
+}
+
+// csvText keeps text written by other users, e.g. a client name like
+// "=HYPERLINK(...)", from running as a formula when the export is opened
+// in a spreadsheet
+func csvText(text string) string {
+	if text != "" && strings.ContainsRune("=+-@\t\r", rune(text[0])) {
+		return "'" + text
+	}
+	return text
+}
+
+// PayoutRequest asks for the worker's available balance to be transferred
+type PayoutRequest struct {
+	Amount  money.Money `json:"amount"`
+	Method  string      `json:"method"`  // "bank", "d17" or "poste"
+	Account string      `json:"account"` // RIB, phone number or card number depending on method
+}
+
+// RequestPayout submits a payout request
+func RequestPayout(config *APIConfig, req PayoutRequest) error {
+	return APIRequestJSON(config, http.MethodPost, "/worker/payouts", req, nil)
+}
@@ -0,0 +1,280 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/dialog"
+	"fyne.io/fyne/v2/widget"
+
+	"skillDar/pkg/i18n"
+	"skillDar/pkg/money"
+)
+
+// payoutMethods maps the labels shown in the form, in English, to the API method names
+var payoutMethods = map[string]string{
+	"Bank transfer (RIB)": "bank",
+	"D17":                 "d17",
+	"La Poste (e-Dinar)":  "poste",
+}
+
+// validatePayoutAccount checks the account number format for a payout method
+func validatePayoutAccount(method, account string) string {
+	digits := 0
+	for _, r := range account {
+		if !unicode.IsDigit(r) && r != ' ' {
+			return i18n.T("Use digits only")
+		}
+		if r != ' ' {
+			digits++
+		}
+	}
+	switch method {
+	case "bank":
+		if digits != 20 {
+			return i18n.T("A RIB has 20 digits")
+		}
+	case "d17":
+		if digits != 8 {
+			return i18n.T("Enter the 8-digit phone number linked to D17")
+		}
+	case "poste":
+		if digits != 16 {
+			return i18n.T("Enter the 16-digit e-Dinar card number")
+		}
+	}
+	return ""
+}
+
+// createEarningLineCard shows one completed job with the commission deducted
+func createEarningLineCard(line EarningLine) fyne.CanvasObject {
+	order := line.Order
+	title := newLabel(fmt.Sprintf("%s • %s", order.ClientName, order.Category))
+	title.TextStyle = fyne.TextStyle{Bold: true}
+	title.Truncation = fyne.TextTruncateEllipsis
+
+	when := newLabel(i18n.FormatDate(order.completedTime(), "Mon 02 Jan 15:04"))
+
+	payout := newLabel(i18n.T("Pending"))
+	switch order.PayoutStatus {
+	case PayoutRequested:
+		payout.SetText(i18n.T("In payout"))
+		payout.Importance = widget.WarningImportance
+	case PayoutPaid:
+		payout.SetText(i18n.T("Paid out"))
+		payout.Importance = widget.SuccessImportance
+	}
+
+	amounts := newLabel(i18n.T("%s − %s commission = %s", line.Gross, line.Commission, line.Net))
+
+	return widget.NewCard("", "", container.NewVBox(
+		newBorderRow(nil, nil, nil, payout, title),
+		when,
+		amounts,
+	))
+}
+
+// CreateEarningsScreen shows the worker's earnings, balances, job history and payout form
+func CreateEarningsScreen(state AppState) fyne.CanvasObject {
+	apiConfig := DefaultAPIConfig()
+
+	title := newLabel(i18n.T("Earnings & Payouts"))
+	title.Alignment = fyne.TextAlignCenter
+	title.TextStyle = fyne.TextStyle{Bold: true}
+
+	var earnings Earnings
+
+	periodRow := container.NewGridWithColumns(3,
+		createStatCard("📅", "-", i18n.T("Today")),
+		createStatCard("🗓", "-", i18n.T("This Week")),
+		createStatCard("💰", "-", i18n.T("This Month")),
+	)
+	balanceRow := container.NewGridWithColumns(3,
+		createStatCard("🟢", "-", i18n.T("Available")),
+		createStatCard("⏳", "-", i18n.T("In Payout")),
+		createStatCard("✓", "-", i18n.T("Paid Out")),
+	)
+
+	historyLabel := newLabel(i18n.T("Completed Jobs"))
+	historyLabel.TextStyle = fyne.TextStyle{Bold: true}
+	historyBox := container.NewVBox(newLabel(i18n.T("Loading...")))
+
+	// Payout request form
+	amountEntry := widget.NewEntry()
+	amountEntry.SetPlaceHolder(i18n.T("e.g. 150.500"))
+	amountError := newLabel("")
+	amountError.Importance = widget.DangerImportance
+	amountError.Hide()
+
+	methodLabels := []string{"Bank transfer (RIB)", "D17", "La Poste (e-Dinar)"}
+	methodOptions := make([]string, len(methodLabels))
+	methodsByOption := make(map[string]string)
+	for i, label := range methodLabels {
+		methodOptions[i] = i18n.T(label)
+		methodsByOption[methodOptions[i]] = payoutMethods[label]
+	}
+	methodSelect := widget.NewSelect(methodOptions, nil)
+	methodSelect.SetSelected(methodOptions[0])
+
+	accountEntry := widget.NewEntry()
+	accountEntry.SetPlaceHolder(i18n.T("RIB, phone or card number"))
+	accountError := newLabel("")
+	accountError.Importance = widget.DangerImportance
+	accountError.Hide()
+
+	showFieldError := func(label *widget.Label, message string) {
+		label.SetText(message)
+		if message == "" {
+			label.Hide()
+		} else {
+			label.Show()
+		}
+	}
+
+	var load func()
+	var payoutBtn *widget.Button
+
+	render := func() {
+		periodRow.Objects = []fyne.CanvasObject{
+			createStatCard("📅", earnings.Today.String(), i18n.T("Today")),
+			createStatCard("🗓", earnings.Week.String(), i18n.T("This Week")),
+			createStatCard("💰", earnings.Month.String(), i18n.T("This Month")),
+		}
+		periodRow.Refresh()
+		balanceRow.Objects = []fyne.CanvasObject{
+			createStatCard("🟢", earnings.Available.String(), i18n.T("Available")),
+			createStatCard("⏳", earnings.InPayout.String(), i18n.T("In Payout")),
+			createStatCard("✓", earnings.PaidOut.String(), i18n.T("Paid Out")),
+		}
+		balanceRow.Refresh()
+
+		historyBox.Objects = nil
+		if len(earnings.Lines) == 0 {
+			historyBox.Add(newLabel(i18n.T("No completed jobs yet")))
+		}
+		for _, line := range earnings.Lines {
+			historyBox.Add(createEarningLineCard(line))
+		}
+		historyBox.Refresh()
+
+		if earnings.Available.Amount > 0 {
+			payoutBtn.Enable()
+		} else {
+			payoutBtn.Disable()
+		}
+	}
+
+	payoutBtn = widget.NewButton(i18n.T("Request Payout"), func() {
+		amount, err := money.Parse(amountEntry.Text, earnings.Available.Cur())
+		switch {
+		case err != nil || amount.Amount <= 0:
+			showFieldError(amountError, i18n.T("Enter a valid amount"))
+		case amount.Cmp(earnings.Available) > 0:
+			showFieldError(amountError, i18n.T("You can withdraw up to %s", earnings.Available.String()))
+		default:
+			showFieldError(amountError, "")
+		}
+
+		method := methodsByOption[methodSelect.Selected]
+		showFieldError(accountError, validatePayoutAccount(method, strings.TrimSpace(accountEntry.Text)))
+		if amountError.Visible() || accountError.Visible() {
+			return
+		}
+
+		req := PayoutRequest{
+			Amount:  amount,
+			Method:  method,
+			Account: strings.ReplaceAll(strings.TrimSpace(accountEntry.Text), " ", ""),
+		}
+		payoutBtn.Disable()
+		go func() {
+			err := RequestPayout(apiConfig, req)
+			fyne.Do(func() {
+				payoutBtn.Enable()
+				if err != nil {
+					state.ShowConnectionError(StatusForError(err))
+					return
+				}
+				amountEntry.SetText("")
+				dialog.ShowInformation(i18n.T("Payout Requested"),
+					i18n.T("%s will be transferred within 3 business days.", req.Amount.String()), state.GetWindow())
+				load()
+			})
+		}()
+	})
+	payoutBtn.Importance = widget.HighImportance
+	payoutBtn.Disable()
+
+	maxBtn := widget.NewButton(i18n.T("Max"), func() {
+		amountEntry.SetText(earnings.Available.Decimal())
+	})
+
+	payoutForm := container.NewVBox(
+		newLabel(i18n.T("Amount (TND)")),
+		newBorderRow(nil, nil, nil, maxBtn, amountEntry),
+		amountError,
+		newLabel(i18n.T("Method")),
+		methodSelect,
+		newLabel(i18n.T("Account")),
+		accountEntry,
+		accountError,
+		payoutBtn,
+	)
+
+	exportBtn := widget.NewButton("⬇ "+i18n.T("Export CSV"), func() {
+		save := dialog.NewFileSave(func(writer fyne.URIWriteCloser, err error) {
+			if err != nil || writer == nil {
+				return
+			}
+			defer writer.Close()
+			if err := WriteEarningsCSV(writer, earnings.Lines); err != nil {
+				dialog.ShowError(err, state.GetWindow())
+			}
+		}, state.GetWindow())
+		save.SetFileName("skilldar-earnings.csv")
+		save.Show()
+	})
+
+	load = func() {
+		go func() {
+			result, err := FetchWorkerEarnings(apiConfig)
+			fyne.Do(func() {
+				if err != nil {
+					state.ShowConnectionError(StatusForError(err))
+					historyBox.Objects = []fyne.CanvasObject{newLabel(i18n.T("Could not load earnings"))}
+					historyBox.Refresh()
+					return
+				}
+				earnings = result
+				render()
+			})
+		}()
+	}
+	load()
+
+	balanceLabel := newLabel(i18n.T("Balance"))
+	balanceLabel.TextStyle = fyne.TextStyle{Bold: true}
+	earningsLabel := newLabel(i18n.T("Net Earnings"))
+	earningsLabel.TextStyle = fyne.TextStyle{Bold: true}
+	payoutLabel := newLabel(i18n.T("Request a Payout"))
+	payoutLabel.TextStyle = fyne.TextStyle{Bold: true}
+
+	content := container.NewVBox(
+		earningsLabel,
+		periodRow,
+		widget.NewSeparator(),
+		balanceLabel,
+		balanceRow,
+		widget.NewSeparator(),
+		payoutLabel,
+		payoutForm,
+		widget.NewSeparator(),
+		newBorderRow(nil, nil, nil, exportBtn, historyLabel),
+		historyBox,
+	)
+
+	return container.NewBorder(title, nil, nil, nil, container.NewVScroll(container.NewPadded(content)))
+}
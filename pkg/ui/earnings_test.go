@@ -0,0 +1,186 @@
+package ui
+
+import (
+	"bytes"
+	"encoding/csv"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"skillDar/pkg/money"
+	"skillDar/pkg/schedule"
+)
+
+// tunisTime returns a time in Africa/Tunis, where the earnings periods start
+func tunisTime(year int, month time.Month, day, hour, minute int) time.Time {
+	return time.Date(year, month, day, hour, minute, 0, 0, schedule.Location())
+}
+
+func TestComputeEarningsPeriods(t *testing.T) {
+	// Thursday 1 October: the week started on Monday 28 September
+	now := tunisTime(2026, time.October, 1, 10, 0)
+	tests := []struct {
+		name                           string
+		completed                      time.Time
+		wantToday, wantWeek, wantMonth bool
+	}{
+		{"this morning", tunisTime(2026, time.October, 1, 8, 0), true, true, true},
+		{"midnight today", tunisTime(2026, time.October, 1, 0, 0), true, true, true},
+		{"today in Tunis, yesterday in UTC", time.Date(2026, time.September, 30, 23, 30, 0, 0, time.UTC), true, true, true},
+		{"last minute of yesterday", tunisTime(2026, time.September, 30, 23, 59), false, true, false},
+		{"monday midnight", tunisTime(2026, time.September, 28, 0, 0), false, true, false},
+		{"sunday before", tunisTime(2026, time.September, 27, 23, 59), false, false, false},
+		{"last month", tunisTime(2026, time.September, 2, 12, 0), false, false, false},
+	}
+	for _, tt := range tests {
+		order := Order{Status: OrderCompleted, Total: money.Dinars(100), CompletedAt: tt.completed}
+		e := ComputeEarnings([]Order{order}, now)
+		net := money.Dinars(85)
+		got := []bool{e.Today == net, e.Week == net, e.Month == net}
+		want := []bool{tt.wantToday, tt.wantWeek, tt.wantMonth}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("%s: today, week, month counted = %v, want %v", tt.name, got, want)
+		}
+	}
+
+	// Without a completion time the scheduled time is used
+	order := Order{Status: OrderCompleted, Total: money.Dinars(100), ScheduledAt: tunisTime(2026, time.September, 29, 9, 0)}
+	if e := ComputeEarnings([]Order{order}, now); e.Today.Amount != 0 || e.Week != money.Dinars(85) {
+		t.Errorf("scheduled on tuesday: today %+v week %+v, want 0 and 85 dinars", e.Today, e.Week)
+	}
+}
+
+func TestComputeEarnings(t *testing.T) {
+	now := tunisTime(2026, time.October, 14, 18, 0)
+	day := func(d int) time.Time { return tunisTime(2026, time.October, d, 12, 0) }
+	orders := []Order{
+		{ID: "paid", Status: OrderCompleted, Total: money.Dinars(100), CompletedAt: day(2), PayoutStatus: PayoutPaid},
+		{ID: "requested", Status: OrderCompleted, Total: money.Dinars(200), CompletedAt: day(13), PayoutStatus: PayoutRequested},
+		{ID: "available", Status: OrderCompleted, Total: money.Dinars(60), CompletedAt: day(14), CommissionRate: 1000},
+		{ID: "refunded", Status: OrderCancelled, Total: money.Dinars(80), ScheduledAt: day(14), PaymentID: "pay_1"},
+		{ID: "declined", Status: OrderDeclined, Total: money.Dinars(90), ScheduledAt: day(14)},
+		{ID: "pending", Status: OrderPending, Total: money.Dinars(50), ScheduledAt: day(15)},
+		{ID: "accepted", Status: OrderAccepted, Total: money.Dinars(70), ScheduledAt: day(16)},
+	}
+	e := ComputeEarnings(orders, now)
+
+	var ids []string
+	for _, line := range e.Lines {
+		ids = append(ids, line.Order.ID)
+	}
+	if want := []string{"available", "requested", "paid"}; !reflect.DeepEqual(ids, want) {
+		t.Fatalf("lines %q, want %q", ids, want)
+	}
+	lines := []struct {
+		gross, commission, net money.Money
+	}{
+		{money.Dinars(60), money.Dinars(6), money.Dinars(54)},    // Own rate of 10%
+		{money.Dinars(200), money.Dinars(30), money.Dinars(170)}, // Default rate of 15%
+		{money.Dinars(100), money.Dinars(15), money.Dinars(85)},
+	}
+	for i, want := range lines {
+		line := e.Lines[i]
+		if line.Gross != want.gross || line.Commission != want.commission || line.Net != want.net {
+			t.Errorf("%s: %v − %v = %v, want %v − %v = %v", line.Order.ID,
+				line.Gross, line.Commission, line.Net, want.gross, want.commission, want.net)
+		}
+	}
+
+	totals := []struct {
+		name      string
+		got, want money.Money
+	}{
+		{"today", e.Today, money.Dinars(54)},
+		{"week", e.Week, money.Dinars(224)},
+		{"month", e.Month, money.Dinars(309)},
+		{"available", e.Available, money.Dinars(54)},
+		{"in payout", e.InPayout, money.Dinars(170)},
+		{"paid out", e.PaidOut, money.Dinars(85)},
+	}
+	for _, tt := range totals {
+		if tt.got != tt.want {
+			t.Errorf("%s = %v, want %v", tt.name, tt.got, tt.want)
+		}
+	}
+
+	if e := ComputeEarnings(nil, now); e.Month.Amount != 0 || e.Available.Amount != 0 || e.Lines != nil {
+		t.Errorf("no orders = %+v, want nothing earned", e)
+	}
+}
+
+func TestWriteEarningsCSV(t *testing.T) {
+	line := func(order Order) EarningLine {
+		order.Status = OrderCompleted
+		return ComputeEarnings([]Order{order}, order.CompletedAt).Lines[0]
+	}
+	lines := []EarningLine{
+		line(Order{
+			ID: "o1", ClientName: "Ben Salah, Amira", Category: "Plumbing", Hours: 2,
+			Total: money.Millimes(90500), CompletedAt: time.Date(2026, time.October, 13, 23, 30, 0, 0, time.UTC),
+		}),
+		line(Order{
+			ID: "o2", ClientName: `Karim "Kiki" Trabelsi`, Category: "Cleaning\nDeep", Hours: 3,
+			Total: money.Dinars(60), CompletedAt: tunisTime(2026, time.October, 2, 9, 5), PayoutStatus: PayoutPaid,
+		}),
+		line(Order{
+			ID: "o4", ClientName: "=HYPERLINK(\"http://x.tn\",\"Pay\")", Category: "@SUM(A1)", Hours: 1,
+			Total: money.Dinars(40), CompletedAt: tunisTime(2026, time.October, 1, 8, 0),
+		}),
+		line(Order{
+			ID: "o3", ClientName: "سامي", Category: "Electricity", Hours: 1,
+			Total: money.Dinars(40), ScheduledAt: tunisTime(2026, time.September, 30, 16, 0), PayoutStatus: PayoutRequested,
+		}),
+	}
+
+	var buf bytes.Buffer
+	if err := WriteEarningsCSV(&buf, lines); err != nil {
+		t.Fatal(err)
+	}
+	want := strings.Join([]string{
+		"Completed,Order,Client,Category,Hours,Gross,Commission,Net,Currency,Payout",
+		`2026-10-14 00:30,o1,"Ben Salah, Amira",Plumbing,2,90.500,13.575,76.925,TND,pending`,
+		`2026-10-02 09:05,o2,"Karim ""Kiki"" Trabelsi","Cleaning` + "\n" + `Deep",3,60.000,9.000,51.000,TND,paid`,
+		`2026-10-01 08:00,o4,"'=HYPERLINK(""http://x.tn"",""Pay"")",'@SUM(A1),1,40.000,6.000,34.000,TND,pending`,
+		"2026-09-30 16:00,o3,سامي,Electricity,1,40.000,6.000,34.000,TND,requested",
+		"",
+	}, "\n")
+	if got := buf.String(); got != want {
+		t.Errorf("CSV =\n%s\nwant\n%s", got, want)
+	}
+
+	// The quoted fields read back as written
+	records, err := csv.NewReader(&buf).ReadAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := records[2][2:4]; !reflect.DeepEqual(got, []string{`Karim "Kiki" Trabelsi`, "Cleaning\nDeep"}) {
+		t.Errorf("read back %q", got)
+	}
+
+	buf.Reset()
+	if err := WriteEarningsCSV(&buf, nil); err != nil || strings.Count(buf.String(), "\n") != 1 {
+		t.Errorf("no lines = %q, %v, want only the header", buf.String(), err)
+	}
+}
+
+func TestCSVText(t *testing.T) {
+	tests := []struct {
+		in, want string
+	}{
+		{"Amira", "Amira"},
+		{"", ""},
+		{"=1+2", "'=1+2"},
+		{"+21620123456", "'+21620123456"},
+		{"-2+3", "'-2+3"},
+		{"@SUM(A1)", "'@SUM(A1)"},
+		{"\t=1", "'\t=1"},
+		{"\r=1", "'\r=1"},
+		{"Ben-Ali = 1", "Ben-Ali = 1"},
+	}
+	for _, tt := range tests {
+		if got := csvText(tt.in); got != tt.want {
+			t.Errorf("csvText(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
@@ -1,77 +1,323 @@
 package ui
 
 import (
-	"fmt"
+	"errors"
+	"net/http"
+	"net/mail"
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
 
 	"fyne.io/fyne/v2"
 	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/dialog"
 	"fyne.io/fyne/v2/layout"
 	"fyne.io/fyne/v2/widget"
+
+	"skillDar/pkg/i18n"
 )
 
+// phonePattern matches a phone number once spaces, dashes, dots and
+// brackets are removed: 8 digits for a Tunisian number, up to 15 with a
+// country code
+var phonePattern = regexp.MustCompile(`^\+?[0-9]{8,15}$`)
+
+// ValidateClientProfile checks the editable client fields.
+// It returns a map of field name to error message, empty when the profile is valid.
+func ValidateClientProfile(p UserProfile) map[string]string {
+	errs := make(map[string]string)
+
+	if n := utf8.RuneCountInString(p.Name); n < 2 || n > 60 {
+		errs["name"] = i18n.T("Name must be between 2 and 60 characters")
+	} else if strings.IndexFunc(p.Name, unicode.IsDigit) >= 0 || strings.IndexFunc(p.Name, unicode.IsLetter) < 0 {
+		errs["name"] = i18n.T("Name can only contain letters")
+	}
+	if addr, err := mail.ParseAddress(p.Email); err != nil || addr.Address != p.Email || !strings.Contains(p.Email[strings.LastIndex(p.Email, "@"):], ".") {
+		errs["email"] = i18n.T("Enter a valid email address, e.g. name@example.com")
+	}
+	if !phonePattern.MatchString(normalizePhone(p.Phone)) {
+		errs["phone"] = i18n.T("Enter a valid phone number, e.g. +216 20 123 456")
+	}
+	if utf8.RuneCountInString(p.Bio) > 500 {
+		errs["bio"] = i18n.T("Bio must be 500 characters or fewer")
+	}
+
+	return errs
+}
+
+// normalizePhone removes the separators people type in phone numbers
+func normalizePhone(phone string) string {
+	return strings.Map(func(r rune) rune {
+		switch r {
+		case ' ', '-', '.', '(', ')':
+			return -1
+		}
+		return r
+	}, phone)
+}
+
+// ProfileChanges returns the fields that differ between two profiles, keyed
+// by JSON field name, for a partial update
+func ProfileChanges(before, after UserProfile) map[string]string {
+	changes := make(map[string]string)
+	set := func(field, old, value string) {
+		if old != value {
+			changes[field] = value
+		}
+	}
+	set("name", before.Name, after.Name)
+	set("email", before.Email, after.Email)
+	set("phone", before.Phone, after.Phone)
+	set("location", before.Location, after.Location)
+	set("bio", before.Bio, after.Bio)
+	return changes
+}
+
+// UpdateMyProfile sends the changed fields of the signed-in user's profile
+// and returns the updated profile
+func UpdateMyProfile(config *APIConfig, changes map[string]string) (UserProfile, error) {
+	var profile UserProfile
+	err := APIRequestJSON(config, http.MethodPatch, "/me", changes, &profile)
+	return profile, err
+}
+
 // CreateEditProfileClientScreen builds the client profile edit screen
 func CreateEditProfileClientScreen(state AppState) fyne.CanvasObject {
+	apiConfig := DefaultAPIConfig()
+
 	// Header
-	title := widget.NewLabel("Edit Client Profile")
+	title := newLabel(i18n.T("Edit Client Profile"))
 	title.Alignment = fyne.TextAlignCenter
 	title.TextStyle = fyne.TextStyle{Bold: true}
 
 	// Profile picture section
-	profilePicBtn := widget.NewButton("Change Profile Picture", func() {
-		fmt.Println("Change profile picture clicked")
-		// TODO: Implement image picker
+	subs := &Subscriptions{}
+	avatar := newUserAvatar(state, 96, subs)
+	profilePicBtn := widget.NewButton(i18n.T("Change Profile Picture"), func() {
+		ShowAvatarPicker(state)
 	})
 
+	// Inline error labels per field
+	errorLabels := make(map[string]*widget.Label)
+	errorLabel := func(field string) *widget.Label {
+		label := newLabel("")
+		label.Importance = widget.DangerImportance
+		label.Wrapping = fyne.TextWrapWord
+		label.Hide()
+		errorLabels[field] = label
+		return label
+	}
+	showErrors := func(errs map[string]string) {
+		for field, label := range errorLabels {
+			if msg, ok := errs[field]; ok {
+				label.SetText(msg)
+				label.Show()
+			} else {
+				label.Hide()
+			}
+		}
+	}
+
 	// Form fields
 	nameEntry := widget.NewEntry()
-	nameEntry.SetPlaceHolder("Full Name")
-	nameEntry.SetText("John Doe") // Pre-filled example
+	nameEntry.SetPlaceHolder(i18n.T("Full Name"))
 
 	emailEntry := widget.NewEntry()
-	emailEntry.SetPlaceHolder("Email")
-	emailEntry.SetText("john@example.com")
+	emailEntry.SetPlaceHolder(i18n.T("Email"))
 
 	phoneEntry := widget.NewEntry()
-	phoneEntry.SetPlaceHolder("Phone Number")
-	phoneEntry.SetText("+2164567890")
+	phoneEntry.SetPlaceHolder(i18n.T("Phone Number"))
 
 	locationEntry := widget.NewEntry()
-	locationEntry.SetPlaceHolder("Location/Address")
-	locationEntry.SetText("New York, USA")
+	locationEntry.SetPlaceHolder(i18n.T("Location/Address"))
 
 	bioEntry := widget.NewMultiLineEntry()
-	bioEntry.SetPlaceHolder("Tell us about yourself...")
+	bioEntry.SetPlaceHolder(i18n.T("Tell us about yourself..."))
 	bioEntry.SetMinRowsVisible(4)
 
-	// Save button
-	saveBtn := widget.NewButton("Save Changes", func() {
-		fmt.Println("Saving client profile...")
-		fmt.Println("Name:", nameEntry.Text)
-		fmt.Println("Email:", emailEntry.Text)
-		fmt.Println("Phone:", phoneEntry.Text)
-		fmt.Println("Location:", locationEntry.Text)
-		fmt.Println("Bio:", bioEntry.Text)
-		// TODO: Send to API
-		state.ShowScreen("main")
+	entries := []*widget.Entry{nameEntry, emailEntry, phoneEntry, locationEntry, bioEntry}
+
+	// original is the profile as last loaded or saved, edits are compared to it
+	original := state.Profile().Profile()
+	loaded := false
+
+	fill := func(p UserProfile) {
+		nameEntry.SetText(p.Name)
+		emailEntry.SetText(p.Email)
+		phoneEntry.SetText(p.Phone)
+		locationEntry.SetText(p.Location)
+		bioEntry.SetText(p.Bio)
+	}
+
+	// collect builds a profile from the form
+	collect := func() UserProfile {
+		p := original
+		p.Name = strings.TrimSpace(nameEntry.Text)
+		p.Email = strings.TrimSpace(emailEntry.Text)
+		p.Phone = strings.TrimSpace(phoneEntry.Text)
+		p.Location = strings.TrimSpace(locationEntry.Text)
+		p.Bio = strings.TrimSpace(bioEntry.Text)
+		return p
+	}
+	dirty := func() bool {
+		return loaded && len(ProfileChanges(original, collect())) > 0
+	}
+
+	statusLabel := newLabel(i18n.T("Loading profile..."))
+	statusLabel.Wrapping = fyne.TextWrapWord
+
+	var saveBtn *widget.Button
+	updateSave := func() {
+		if dirty() {
+			saveBtn.Enable()
+		} else {
+			saveBtn.Disable()
+		}
+	}
+	setEditable := func(editable bool) {
+		for _, e := range entries {
+			if editable {
+				e.Enable()
+			} else {
+				e.Disable()
+			}
+		}
+	}
+
+	saveBtn = widget.NewButton(i18n.T("Save Changes"), func() {
+		profile := collect()
+		errs := ValidateClientProfile(profile)
+		showErrors(errs)
+		if len(errs) > 0 {
+			statusLabel.SetText(i18n.T("Please fix the highlighted fields"))
+			return
+		}
+
+		changes := ProfileChanges(original, profile)
+		saveBtn.Disable()
+		setEditable(false)
+		statusLabel.SetText(i18n.T("Saving..."))
+		go func() {
+			updated, err := UpdateMyProfile(apiConfig, changes)
+			fyne.Do(func() {
+				setEditable(true)
+				if err != nil {
+					updateSave()
+					statusLabel.SetText("")
+					var apiErr *APIError
+					if errors.As(err, &apiErr) && len(apiErr.Fields) > 0 {
+						showErrors(apiErr.Fields)
+						statusLabel.SetText(i18n.T("Please fix the highlighted fields"))
+						return
+					}
+					state.ShowConnectionError(StatusForError(err))
+					return
+				}
+				original = updated
+				state.Profile().Set(updated)
+				fill(updated)
+				statusLabel.SetText("✓ " + i18n.T("Profile saved"))
+				updateSave()
+				state.GoBack()
+			})
+		}()
 	})
 	saveBtn.Importance = widget.HighImportance
+	saveBtn.Disable()
+
+	for _, e := range entries {
+		e.OnChanged = func(string) { updateSave() }
+	}
+
+	retryBtn := widget.NewButton(i18n.T("Retry"), nil)
+	retryBtn.Hide()
+
+	// load fetches the current profile, the form stays read-only until it
+	// arrives so edits are never compared against stale data. When loading
+	// fails it can be tried again.
+	var load func()
+	load = func() {
+		loaded = false
+		setEditable(false)
+		saveBtn.Disable()
+		retryBtn.Hide()
+		showErrors(nil)
+		statusLabel.SetText(i18n.T("Loading profile..."))
+		go func() {
+			profile, err := FetchMyProfile(apiConfig)
+			fyne.Do(func() {
+				if err != nil {
+					statusLabel.SetText(i18n.T("Could not load your profile"))
+					retryBtn.Show()
+					state.ShowConnectionError(StatusForError(err))
+					return
+				}
+				state.Profile().Set(profile)
+				original = profile
+				fill(profile)
+				loaded = true
+				setEditable(true)
+				statusLabel.SetText("")
+				updateSave()
+			})
+		}()
+	}
+	retryBtn.OnTapped = load
+	fill(original)
+	load()
+
+	sectionLabel := func(text string) *widget.Label {
+		label := newLabel(text)
+		label.TextStyle = fyne.TextStyle{Bold: true}
+		return label
+	}
 
 	// Layout
 	content := container.NewVBox(
 		title,
 		layout.NewSpacer(),
+		container.NewCenter(avatar),
 		profilePicBtn,
-		widget.NewLabel("Personal Information"),
+		sectionLabel(i18n.T("Personal Information")),
 		nameEntry,
+		errorLabel("name"),
 		emailEntry,
+		errorLabel("email"),
 		phoneEntry,
+		errorLabel("phone"),
 		locationEntry,
-		widget.NewLabel("Bio"),
+		errorLabel("location"),
+		sectionLabel(i18n.T("Bio")),
 		bioEntry,
+		errorLabel("bio"),
 		layout.NewSpacer(),
+		statusLabel,
+		retryBtn,
 		saveBtn,
 	)
 
-	scroll := container.NewVScroll(content)
-	return scroll
+	screen := NewScreen(container.NewVScroll(content))
+	screen.ShowFunc = func() {
+		if screen.Revisited() {
+			load()
+		}
+	}
+	screen.DestroyFunc = subs.Release
+	screen.LeaveFunc = func(leave func()) bool {
+		if !dirty() {
+			return true
+		}
+		confirm := dialog.NewConfirm(i18n.T("Discard changes?"), i18n.T("Your edits to your profile have not been saved."), func(discard bool) {
+			if discard {
+				loaded = false // Nothing left to save
+				leave()
+			}
+		}, state.GetWindow())
+		confirm.SetConfirmText(i18n.T("Discard"))
+		confirm.SetDismissText(i18n.T("Keep Editing"))
+		confirm.Show()
+		return false
+	}
+	return screen
 }
@@ -0,0 +1,88 @@
+package ui
+
+import (
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+)
+
+func TestValidateClientProfile(t *testing.T) {
+	valid := UserProfile{
+		Name:  "Amira Ben Salah",
+		Email: "amira@example.tn",
+		Phone: "+216 20 123 456",
+		Bio:   "Looking for reliable plumbers.",
+	}
+	tests := []struct {
+		name       string
+		edit       func(p *UserProfile)
+		wantFields []string
+	}{
+		{"valid", func(p *UserProfile) {}, nil},
+		{"arabic name", func(p *UserProfile) { p.Name = "أميرة بن صالح" }, nil},
+		{"name with accents and hyphen", func(p *UserProfile) { p.Name = "Hélène Ben-Ali" }, nil},
+		{"name too short", func(p *UserProfile) { p.Name = "A" }, []string{"name"}},
+		{"name too long", func(p *UserProfile) { p.Name = strings.Repeat("a", 61) }, []string{"name"}},
+		{"name of 60 letters", func(p *UserProfile) { p.Name = strings.Repeat("ب", 60) }, nil},
+		{"name with digits", func(p *UserProfile) { p.Name = "Amira 2" }, []string{"name"}},
+		{"name without letters", func(p *UserProfile) { p.Name = "--" }, []string{"name"}},
+		{"empty email", func(p *UserProfile) { p.Email = "" }, []string{"email"}},
+		{"email without domain dot", func(p *UserProfile) { p.Email = "amira@example" }, []string{"email"}},
+		{"email with display name", func(p *UserProfile) { p.Email = "Amira <amira@example.tn>" }, []string{"email"}},
+		{"local phone", func(p *UserProfile) { p.Phone = "20123456" }, nil},
+		{"phone with separators", func(p *UserProfile) { p.Phone = "(+216) 20-123.456" }, nil},
+		{"phone too short", func(p *UserProfile) { p.Phone = "2012345" }, []string{"phone"}},
+		{"phone too long", func(p *UserProfile) { p.Phone = "+2162012345678901" }, []string{"phone"}},
+		{"phone with letters", func(p *UserProfile) { p.Phone = "20 12 AB 56" }, []string{"phone"}},
+		{"bio of 500 characters", func(p *UserProfile) { p.Bio = strings.Repeat("é", 500) }, nil},
+		{"bio too long", func(p *UserProfile) { p.Bio = strings.Repeat("é", 501) }, []string{"bio"}},
+		{"everything wrong", func(p *UserProfile) { *p = UserProfile{} }, []string{"email", "name", "phone"}},
+	}
+	for _, tt := range tests {
+		p := valid
+		tt.edit(&p)
+		var fields []string
+		for field := range ValidateClientProfile(p) {
+			fields = append(fields, field)
+		}
+		sort.Strings(fields)
+		if !reflect.DeepEqual(fields, tt.wantFields) {
+			t.Errorf("%s: invalid fields = %q, want %q", tt.name, fields, tt.wantFields)
+		}
+	}
+}
+
+func TestProfileChanges(t *testing.T) {
+	before := UserProfile{
+		ID:        "u1",
+		Name:      "Amira",
+		Email:     "amira@example.tn",
+		Phone:     "20123456",
+		Location:  "Tunis",
+		Bio:       "Hello",
+		AvatarURL: "https://cdn.example.tn/a.jpg",
+	}
+	tests := []struct {
+		name string
+		edit func(p *UserProfile)
+		want map[string]string
+	}{
+		{"unchanged", func(p *UserProfile) {}, map[string]string{}},
+		{"name", func(p *UserProfile) { p.Name = "Amira B." }, map[string]string{"name": "Amira B."}},
+		{"cleared bio", func(p *UserProfile) { p.Bio = "" }, map[string]string{"bio": ""}},
+		{"several fields", func(p *UserProfile) {
+			p.Email, p.Phone, p.Location = "a@example.tn", "+21620123456", "Sfax"
+		}, map[string]string{"email": "a@example.tn", "phone": "+21620123456", "location": "Sfax"}},
+		{"fields that are not edited", func(p *UserProfile) {
+			p.ID, p.AvatarURL = "u2", ""
+		}, map[string]string{}},
+	}
+	for _, tt := range tests {
+		after := before
+		tt.edit(&after)
+		if got := ProfileChanges(before, after); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: ProfileChanges = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
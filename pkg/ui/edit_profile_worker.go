@@ -0,0 +1,400 @@
+package ui
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/dialog"
+	"fyne.io/fyne/v2/storage"
+	"fyne.io/fyne/v2/widget"
+
+	"skillDar/pkg/i18n"
+	"skillDar/pkg/money"
+)
+
+// maxPortfolioPhotoSize is the largest portfolio picture accepted for upload
+const maxPortfolioPhotoSize = 5 << 20 // 5 MB
+
+// skillCategory groups the skills a worker can offer in one professional category
+type skillCategory struct {
+	Name   string
+	Skills []string
+}
+
+// workerSkillCatalog lists the skills offered per category, in display order.
+// Names are in English as stored by the API, the form shows them translated.
+func workerSkillCatalog() []skillCategory {
+	return []skillCategory{
+		{"Plumbing", []string{"Pipe Installation", "Leak Repairs", "Drain Cleaning", "Water Heater Services", "Bathroom Fittings"}},
+		{"Electricity", []string{"Electrical Wiring", "Fixture Installation", "Circuit Breakers", "Outlet Repair", "Lighting"}},
+		{"Painting", []string{"Interior Painting", "Exterior Painting", "Wallpaper", "Plastering"}},
+		{"AC Fixing", []string{"Air Conditioner Installation", "AC Maintenance", "Gas Refill", "Ventilation"}},
+		{"Home Cleaning", []string{"Regular Cleaning", "Deep Cleaning", "Move-out Cleaning", "Window Cleaning"}},
+		{"Small Repairs", []string{"Door Repair", "Shelf Mounting", "Tile Repair", "General Handyman"}},
+		{"Furniture Assembly", []string{"Flat-pack Assembly", "Kitchen Cabinets", "Bed Assembly", "Wardrobes"}},
+		{"Water Leakage", []string{"Leak Detection", "Waterproofing", "Roof Leaks", "Pipe Sealing"}},
+		{"Appliance Repair", []string{"Washing Machines", "Refrigerators", "Ovens", "Dishwashers"}},
+		{"Locksmiths", []string{"Lockout Service", "Lock Replacement", "Key Duplication", "Safe Opening"}},
+	}
+}
+
+// ValidateWorkerProfile checks the editable worker fields.
+// It returns a map of field name to error message, empty when the profile is valid.
+func ValidateWorkerProfile(p WorkerProfile) map[string]string {
+	errs := make(map[string]string)
+
+	if p.Profession == "" {
+		errs["profession"] = i18n.T("Choose your main category")
+	}
+	if len(p.Skills) == 0 {
+		errs["skills"] = i18n.T("Select at least one skill")
+	}
+	if p.HourlyRate.Cmp(money.Dinars(10)) < 0 || p.HourlyRate.Cmp(money.Dinars(1000)) > 0 {
+		errs["hourly_rate"] = i18n.T("Hourly rate must be between 10 and 1000 TND")
+	}
+	if p.MinimumHours < 1 || p.MinimumHours > 8 {
+		errs["minimum_hours"] = i18n.T("Minimum hours must be between 1 and 8")
+	}
+	if p.YearsExperience < 0 || p.YearsExperience > 60 {
+		errs["years_experience"] = i18n.T("Years of experience must be between 0 and 60")
+	}
+	if about := strings.TrimSpace(p.About); len(about) < 20 {
+		errs["about"] = i18n.T("Tell clients a bit more about your work (20 characters minimum)")
+	} else if len(about) > 1000 {
+		errs["about"] = i18n.T("About must be 1000 characters or fewer")
+	}
+	if p.ServiceArea == nil {
+		errs["service_area"] = i18n.T("Tap the map to set the centre of your service area")
+	} else if p.ServiceArea.RadiusKm < 1 || p.ServiceArea.RadiusKm > 50 {
+		errs["service_area"] = i18n.T("Service radius must be between 1 and 50 km")
+	}
+
+	return errs
+}
+
+// FetchMyWorkerProfile returns the signed-in worker's professional profile
+func FetchMyWorkerProfile(config *APIConfig) (WorkerProfile, error) {
+	var profile WorkerProfile
+	err := APIRequestJSON(config, http.MethodGet, "/worker/profile", nil, &profile)
+	return profile, err
+}
+
+// SaveMyWorkerProfile stores the signed-in worker's professional profile
+func SaveMyWorkerProfile(config *APIConfig, profile WorkerProfile) error {
+	return APIRequestJSON(config, http.MethodPut, "/worker/profile", profile, nil)
+}
+
+// UploadPortfolioPhoto uploads a picture to the signed-in worker's portfolio
+func UploadPortfolioPhoto(config *APIConfig, filename string, content io.Reader) (PortfolioPhoto, error) {
+	var photo PortfolioPhoto
+	err := APIUploadFile(config, "/worker/portfolio", "photo", filename, content, &photo)
+	return photo, err
+}
+
+// DeletePortfolioPhoto removes a picture from the signed-in worker's portfolio
+func DeletePortfolioPhoto(config *APIConfig, photoID string) error {
+	return APIRequestJSON(config, http.MethodDelete, "/worker/portfolio/"+url.PathEscape(photoID), nil, nil)
+}
+
+// CreateEditProfileWorkerScreen builds the worker professional profile edit screen
+func CreateEditProfileWorkerScreen(state AppState) fyne.CanvasObject {
+	apiConfig := DefaultAPIConfig()
+	catalog := workerSkillCatalog()
+
+	// Header
+	title := newLabel(i18n.T("Edit Professional Profile"))
+	title.Alignment = fyne.TextAlignCenter
+	title.TextStyle = fyne.TextStyle{Bold: true}
+
+	// Inline error labels per field
+	errorLabels := make(map[string]*widget.Label)
+	errorLabel := func(field string) *widget.Label {
+		label := newLabel("")
+		label.Importance = widget.DangerImportance
+		label.Wrapping = fyne.TextWrapWord
+		label.Hide()
+		errorLabels[field] = label
+		return label
+	}
+
+	// Main category. untranslated maps the shown category and skill names back
+	// to the names the API stores.
+	untranslated := make(map[string]string)
+	categoryNames := make([]string, len(catalog))
+	for i, c := range catalog {
+		categoryNames[i] = i18n.T(c.Name)
+		untranslated[categoryNames[i]] = c.Name
+	}
+	professionSelect := widget.NewSelect(categoryNames, nil)
+	professionSelect.PlaceHolder = i18n.T("Main category")
+
+	// Skills per category
+	skillGroups := make(map[string]*widget.CheckGroup)
+	skillsAccordion := widget.NewAccordion()
+	for _, c := range catalog {
+		skillNames := make([]string, len(c.Skills))
+		for i, skill := range c.Skills {
+			skillNames[i] = i18n.T(skill)
+			untranslated[skillNames[i]] = skill
+		}
+		group := widget.NewCheckGroup(skillNames, nil)
+		skillGroups[c.Name] = group
+		skillsAccordion.Append(widget.NewAccordionItem(i18n.T(c.Name), group))
+	}
+
+	// Rates and experience
+	rateEntry := widget.NewEntry()
+	rateEntry.SetPlaceHolder(i18n.T("Hourly rate (TND)"))
+	minHoursSelect := widget.NewSelect([]string{"1", "2", "3", "4", "5", "6", "7", "8"}, nil)
+	minHoursSelect.SetSelected("2")
+	experienceEntry := widget.NewEntry()
+	experienceEntry.SetPlaceHolder(i18n.T("Years of experience"))
+
+	aboutEntry := widget.NewMultiLineEntry()
+	aboutEntry.SetPlaceHolder(i18n.T("Describe your experience and the jobs you do..."))
+	aboutEntry.SetMinRowsVisible(4)
+
+	// Service area
+	areaPicker := NewLocationPicker(DefaultMapCenter, 11)
+	radiusLabel := newLabel("")
+	radiusSlider := widget.NewSlider(1, 50)
+	radiusSlider.Step = 1
+	radiusSlider.OnChanged = func(km float64) {
+		radiusLabel.SetText(i18n.T("Service radius: %s km", i18n.FormatDecimal(km, 0)))
+		areaPicker.SetRadius(km)
+	}
+	radiusSlider.SetValue(10)
+
+	// Portfolio
+	var portfolio []PortfolioPhoto
+	portfolioBox := container.NewVBox()
+	var refreshPortfolio func()
+	refreshPortfolio = func() {
+		portfolioBox.Objects = nil
+		if len(portfolio) == 0 {
+			portfolioBox.Add(newLabel(i18n.T("No photos yet")))
+		}
+		for _, p := range portfolio {
+			photo := p
+			link := newLabel(photo.Caption)
+			link.Truncation = fyne.TextTruncateEllipsis
+			thumbnail := NewRemoteImage(photo.URL, fyne.NewSize(48, 48), false)
+			removeBtn := widget.NewButton("✕", func() {
+				go func() {
+					err := DeletePortfolioPhoto(apiConfig, photo.ID)
+					fyne.Do(func() {
+						if err != nil {
+							state.ShowConnectionError(StatusForError(err))
+							return
+						}
+						for i, other := range portfolio {
+							if other.ID == photo.ID {
+								portfolio = append(portfolio[:i], portfolio[i+1:]...)
+								break
+							}
+						}
+						refreshPortfolio()
+					})
+				}()
+			})
+			removeBtn.Importance = widget.LowImportance
+			portfolioBox.Add(newBorderRow(nil, nil, thumbnail, removeBtn, link))
+		}
+		portfolioBox.Refresh()
+	}
+	refreshPortfolio()
+
+	uploadStatus := newLabel("")
+	addPhotoBtn := widget.NewButton("＋ "+i18n.T("Add Portfolio Photo"), func() {
+		picker := dialog.NewFileOpen(func(reader fyne.URIReadCloser, err error) {
+			if err != nil || reader == nil {
+				return
+			}
+			data, err := io.ReadAll(io.LimitReader(reader, maxPortfolioPhotoSize+1))
+			name := reader.URI().Name()
+			reader.Close()
+			if err != nil {
+				uploadStatus.SetText("⚠ " + i18n.T("Could not read the photo"))
+				return
+			}
+			if len(data) > maxPortfolioPhotoSize {
+				uploadStatus.SetText("⚠ " + i18n.T("Photos must be 5 MB or smaller"))
+				return
+			}
+
+			uploadStatus.SetText(i18n.T("Uploading %s...", name))
+			go func() {
+				photo, err := UploadPortfolioPhoto(apiConfig, name, bytes.NewReader(data))
+				fyne.Do(func() {
+					if err != nil {
+						uploadStatus.SetText("")
+						state.ShowConnectionError(StatusForError(err))
+						return
+					}
+					uploadStatus.SetText("")
+					portfolio = append(portfolio, photo)
+					refreshPortfolio()
+				})
+			}()
+		}, state.GetWindow())
+		picker.SetFilter(storage.NewExtensionFileFilter([]string{".jpg", ".jpeg", ".png"}))
+		picker.Show()
+	})
+
+	// collect builds a profile from the form
+	collect := func() WorkerProfile {
+		var skills []string
+		for _, c := range catalog {
+			for _, skill := range skillGroups[c.Name].Selected {
+				skills = append(skills, untranslated[skill])
+			}
+		}
+		sort.Strings(skills)
+
+		rate, err := money.Parse(rateEntry.Text, money.TND)
+		if err != nil {
+			rate = money.Millimes(-1)
+		}
+		minHours, _ := strconv.Atoi(minHoursSelect.Selected)
+		years, err := strconv.Atoi(strings.TrimSpace(experienceEntry.Text))
+		if err != nil {
+			years = -1
+		}
+
+		profile := WorkerProfile{
+			Profession:      untranslated[professionSelect.Selected],
+			Skills:          skills,
+			HourlyRate:      rate,
+			MinimumHours:    minHours,
+			YearsExperience: years,
+			About:           strings.TrimSpace(aboutEntry.Text),
+			Portfolio:       portfolio,
+		}
+		if center, ok := areaPicker.Pin(); ok {
+			profile.ServiceArea = &ServiceArea{Center: center, RadiusKm: radiusSlider.Value}
+		}
+		return profile
+	}
+
+	statusLabel := newLabel("")
+	statusLabel.Wrapping = fyne.TextWrapWord
+
+	var saveBtn *widget.Button
+	saveBtn = widget.NewButton(i18n.T("Save Profile"), func() {
+		profile := collect()
+		errs := ValidateWorkerProfile(profile)
+		for field, label := range errorLabels {
+			if msg, ok := errs[field]; ok {
+				label.SetText(msg)
+				label.Show()
+			} else {
+				label.Hide()
+			}
+		}
+		if len(errs) > 0 {
+			statusLabel.SetText(i18n.T("Please fix the highlighted fields"))
+			return
+		}
+
+		saveBtn.Disable()
+		statusLabel.SetText(i18n.T("Saving..."))
+		go func() {
+			err := SaveMyWorkerProfile(apiConfig, profile)
+			fyne.Do(func() {
+				saveBtn.Enable()
+				if err != nil {
+					statusLabel.SetText("")
+					state.ShowConnectionError(StatusForError(err))
+					return
+				}
+				statusLabel.SetText("✓ " + i18n.T("Profile saved"))
+			})
+		}()
+	})
+	saveBtn.Importance = widget.HighImportance
+
+	// Load the current profile
+	go func() {
+		profile, err := FetchMyWorkerProfile(apiConfig)
+		if err != nil {
+			return
+		}
+		fyne.Do(func() {
+			professionSelect.SetSelected(i18n.T(profile.Profession))
+			for _, group := range skillGroups {
+				var selected []string
+				for _, skill := range group.Options {
+					for _, have := range profile.Skills {
+						if skill == i18n.T(have) {
+							selected = append(selected, skill)
+						}
+					}
+				}
+				group.SetSelected(selected)
+			}
+			if profile.HourlyRate.Amount > 0 {
+				rateEntry.SetText(profile.HourlyRate.Decimal())
+			}
+			if profile.MinimumHours > 0 {
+				minHoursSelect.SetSelected(strconv.Itoa(profile.MinimumHours))
+			}
+			experienceEntry.SetText(strconv.Itoa(profile.YearsExperience))
+			aboutEntry.SetText(profile.About)
+			if profile.ServiceArea != nil {
+				areaPicker.SetPin(profile.ServiceArea.Center)
+				radiusSlider.SetValue(profile.ServiceArea.RadiusKm)
+			}
+			portfolio = profile.Portfolio
+			refreshPortfolio()
+		})
+	}()
+
+	sectionLabel := func(text string) *widget.Label {
+		label := newLabel(text)
+		label.TextStyle = fyne.TextStyle{Bold: true}
+		return label
+	}
+
+	content := container.NewVBox(
+		title,
+		sectionLabel(i18n.T("Category & Skills")),
+		professionSelect,
+		errorLabel("profession"),
+		skillsAccordion,
+		errorLabel("skills"),
+		widget.NewSeparator(),
+		sectionLabel(i18n.T("Rates")),
+		container.NewGridWithColumns(2, rateEntry, minHoursSelect),
+		errorLabel("hourly_rate"),
+		errorLabel("minimum_hours"),
+		experienceEntry,
+		errorLabel("years_experience"),
+		sectionLabel(i18n.T("About")),
+		aboutEntry,
+		errorLabel("about"),
+		widget.NewSeparator(),
+		sectionLabel(i18n.T("Portfolio")),
+		portfolioBox,
+		uploadStatus,
+		addPhotoBtn,
+		widget.NewSeparator(),
+		sectionLabel(i18n.T("Service Area")),
+		newLabel(i18n.T("Tap the map to set where you work from")),
+		areaPicker,
+		radiusLabel,
+		radiusSlider,
+		errorLabel("service_area"),
+		widget.NewSeparator(),
+		statusLabel,
+		saveBtn,
+	)
+
+	return container.NewVScroll(content)
+}
@@ -0,0 +1,214 @@
+package ui
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"sync"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/widget"
+)
+
+// Preference keys used to cache favorites for offline viewing
+const (
+	prefFavoritesCache   = "favorites.cache"
+	prefFavoritesPending = "favorites.pending"
+)
+
+// FavoritesStore keeps the client's saved workers in sync with the server.
+// Changes are applied locally first and cached in preferences, so the list
+// stays usable offline; pending changes are pushed on the next Sync.
+type FavoritesStore struct {
+	mu        sync.Mutex
+	pushMu    sync.Mutex // Held while pushing, so pushes run one at a time
+	prefs     fyne.Preferences
+	config    *APIConfig
+	workers   []WorkerProfile
+	pending   map[string]bool // worker ID -> true (add) / false (remove)
+	listeners listenerList
+}
+
+// NewFavoritesStore creates a favorites store and loads the local cache
+func NewFavoritesStore(app fyne.App, config *APIConfig) *FavoritesStore {
+	fs := &FavoritesStore{
+		prefs:   app.Preferences(),
+		config:  config,
+		pending: make(map[string]bool),
+	}
+	fs.loadCache()
+	return fs
+}
+
+// OnChanged registers a callback run on the UI thread whenever favorites
+// change. Call the returned function to remove it.
+func (fs *FavoritesStore) OnChanged(listener func()) (remove func()) {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+	id := fs.listeners.add(listener)
+	return func() {
+		fs.mu.Lock()
+		defer fs.mu.Unlock()
+		fs.listeners.remove(id)
+	}
+}
+
+// List returns a copy of the saved workers
+func (fs *FavoritesStore) List() []WorkerProfile {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+	return append([]WorkerProfile(nil), fs.workers...)
+}
+
+// IsFavorite reports whether the worker with the given ID is saved
+func (fs *FavoritesStore) IsFavorite(workerID string) bool {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+	return fs.indexOf(workerID) >= 0
+}
+
+// Toggle saves or removes a worker and returns whether it is now a favorite
+func (fs *FavoritesStore) Toggle(worker WorkerProfile) bool {
+	fs.mu.Lock()
+	saved := false
+	if i := fs.indexOf(worker.ID); i >= 0 {
+		fs.workers = append(fs.workers[:i], fs.workers[i+1:]...)
+	} else {
+		fs.workers = append(fs.workers, worker)
+		saved = true
+	}
+	fs.pending[worker.ID] = saved
+	fs.saveCache()
+	fs.mu.Unlock()
+
+	fs.notify()
+	go fs.push()
+	return saved
+}
+
+// Sync pushes pending changes and then refreshes the list from the server.
+// onDone is called on the UI thread and receives nil on success.
+func (fs *FavoritesStore) Sync(onDone func(error)) {
+	go func() {
+		err := fs.push()
+		if err == nil {
+			var workers []WorkerProfile
+			err = APIRequestJSON(fs.config, http.MethodGet, "/favorites", nil, &workers)
+			if err == nil {
+				fs.mu.Lock()
+				fs.workers = workers
+				fs.saveCache()
+				fs.mu.Unlock()
+				fs.notify()
+			}
+		}
+		if onDone != nil {
+			fyne.Do(func() { onDone(err) })
+		}
+	}()
+}
+
+// push sends pending add/remove operations to the server. A push started
+// while another runs waits for it, so the same operation is never sent twice
+// at once and operations reach the server in order.
+func (fs *FavoritesStore) push() error {
+	fs.pushMu.Lock()
+	defer fs.pushMu.Unlock()
+
+	fs.mu.Lock()
+	pending := make(map[string]bool, len(fs.pending))
+	for id, saved := range fs.pending {
+		pending[id] = saved
+	}
+	fs.mu.Unlock()
+
+	for id, saved := range pending {
+		method := http.MethodDelete
+		if saved {
+			method = http.MethodPut
+		}
+		if err := APIRequestJSON(fs.config, method, "/favorites/"+url.PathEscape(id), nil, nil); err != nil {
+			return err
+		}
+
+		fs.mu.Lock()
+		// Only clear the entry if it was not toggled again meanwhile
+		if current, ok := fs.pending[id]; ok && current == saved {
+			delete(fs.pending, id)
+			fs.saveCache()
+		}
+		fs.mu.Unlock()
+	}
+	return nil
+}
+
+func (fs *FavoritesStore) notify() {
+	fs.mu.Lock()
+	listeners := fs.listeners.snapshot()
+	fs.mu.Unlock()
+
+	fyne.Do(func() {
+		for _, listener := range listeners {
+			listener()
+		}
+	})
+}
+
+// indexOf returns the position of a worker in the list, caller must hold mu
+func (fs *FavoritesStore) indexOf(workerID string) int {
+	for i, w := range fs.workers {
+		if w.ID == workerID {
+			return i
+		}
+	}
+	return -1
+}
+
+func (fs *FavoritesStore) loadCache() {
+	if data := fs.prefs.String(prefFavoritesCache); data != "" {
+		if err := json.Unmarshal([]byte(data), &fs.workers); err != nil {
+			fyne.LogError("Failed to read favorites cache", err)
+		}
+	}
+	if data := fs.prefs.String(prefFavoritesPending); data != "" {
+		if err := json.Unmarshal([]byte(data), &fs.pending); err != nil {
+			fyne.LogError("Failed to read pending favorites", err)
+		}
+	}
+}
+
+// saveCache persists the list and pending operations, caller must hold mu
+func (fs *FavoritesStore) saveCache() {
+	if data, err := json.Marshal(fs.workers); err == nil {
+		fs.prefs.SetString(prefFavoritesCache, string(data))
+	}
+	if data, err := json.Marshal(fs.pending); err == nil {
+		fs.prefs.SetString(prefFavoritesPending, string(data))
+	}
+}
+
+// newFavoriteButton creates a heart toggle that saves or removes a worker.
+// It follows favorite changes until subs is released.
+func newFavoriteButton(state AppState, worker WorkerProfile, subs *Subscriptions) *widget.Button {
+	favorites := state.Favorites()
+
+	var btn *widget.Button
+	update := func() {
+		if favorites.IsFavorite(worker.ID) {
+			btn.SetText("♥")
+			btn.Importance = widget.DangerImportance
+		} else {
+			btn.SetText("♡")
+			btn.Importance = widget.LowImportance
+		}
+		btn.Refresh()
+	}
+
+	btn = widget.NewButton("♡", func() {
+		favorites.Toggle(worker)
+	})
+	update()
+	subs.Add(favorites.OnChanged(update))
+
+	return btn
+}
@@ -0,0 +1,59 @@
+package ui
+
+// listenerList holds the change callbacks registered on a store, in the
+// order they were added. The store guards it with its own mutex.
+type listenerList struct {
+	lastID  int
+	entries []listenerEntry
+}
+
+type listenerEntry struct {
+	id       int
+	listener func()
+}
+
+// add registers a listener and returns the ID to remove it with
+func (l *listenerList) add(listener func()) int {
+	l.lastID++
+	l.entries = append(l.entries, listenerEntry{id: l.lastID, listener: listener})
+	return l.lastID
+}
+
+// remove drops the listener with the ID, it does nothing if already removed
+func (l *listenerList) remove(id int) {
+	for i, e := range l.entries {
+		if e.id == id {
+			l.entries = append(l.entries[:i:i], l.entries[i+1:]...)
+			return
+		}
+	}
+}
+
+// snapshot returns the listeners to run outside the lock
+func (l *listenerList) snapshot() []func() {
+	listeners := make([]func(), len(l.entries))
+	for i, e := range l.entries {
+		listeners[i] = e.listener
+	}
+	return listeners
+}
+
+// Subscriptions collects the remove functions of the store listeners a
+// screen registers, e.g. one per worker card, so they are all removed when
+// the screen is destroyed or its list is filled again
+type Subscriptions struct {
+	removes []func()
+}
+
+// Add keeps the function that removes a listener
+func (s *Subscriptions) Add(remove func()) {
+	s.removes = append(s.removes, remove)
+}
+
+// Release removes all listeners added so far
+func (s *Subscriptions) Release() {
+	for _, remove := range s.removes {
+		remove()
+	}
+	s.removes = nil
+}
@@ -0,0 +1,294 @@
+package ui
+
+import (
+	"image/color"
+	"math"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+	xwidget "fyne.io/x/fyne/widget"
+
+	skilltheme "skillDar/pkg/theme"
+)
+
+// mapTileSize is the size of a map tile in canvas units
+const mapTileSize = 256
+
+// LatLon is a geographic coordinate in degrees
+type LatLon struct {
+	Lat float64 `json:"lat"`
+	Lon float64 `json:"lon"`
+}
+
+// DefaultMapCenter is shown when no location has been picked yet (Tunis)
+var DefaultMapCenter = LatLon{Lat: 36.8065, Lon: 10.1815}
+
+// LocationPicker shows a map where the user drops a pin by tapping.
+// An optional radius is drawn around the pin, e.g. for a service area.
+//
+// The map widget does not expose its position, so the picker drives it with
+// its own controls and mirrors the zoom and tile offsets to project taps.
+type LocationPicker struct {
+	widget.BaseWidget
+
+	// OnChanged is called when the user drops a pin
+	OnChanged func(LatLon)
+
+	mapWidget *xwidget.Map
+	overlay   *pickerOverlay
+	zoom      int
+	x, y      int
+	pin       *LatLon
+	radiusKm  float64
+}
+
+// NewLocationPicker creates a picker centered on the given coordinate
+func NewLocationPicker(center LatLon, zoom int) *LocationPicker {
+	p := &LocationPicker{
+		mapWidget: xwidget.NewMapWithOptions(
+			xwidget.WithZoomButtons(false),
+			xwidget.WithScrollButtons(false),
+		),
+	}
+	p.overlay = newPickerOverlay(p)
+	p.ExtendBaseWidget(p)
+	p.centerOn(center, zoom)
+	return p
+}
+
+// Pin returns the picked location, ok is false if no pin was dropped yet
+func (p *LocationPicker) Pin() (LatLon, bool) {
+	if p.pin == nil {
+		return LatLon{}, false
+	}
+	return *p.pin, true
+}
+
+// SetPin places the pin and centers the map on it
+func (p *LocationPicker) SetPin(location LatLon) {
+	p.pin = &location
+	p.centerOn(location, p.zoom)
+}
+
+// SetRadius sets the radius in kilometres drawn around the pin, 0 hides it
+func (p *LocationPicker) SetRadius(km float64) {
+	p.radiusKm = km
+	p.overlay.Refresh()
+}
+
+// CreateRenderer implements fyne.Widget
+func (p *LocationPicker) CreateRenderer() fyne.WidgetRenderer {
+	controls := container.NewGridWithColumns(6,
+		widget.NewButtonWithIcon("", theme.NavigateBackIcon(), func() { p.pan(-1, 0) }),
+		widget.NewButtonWithIcon("", theme.MoveUpIcon(), func() { p.pan(0, -1) }),
+		widget.NewButtonWithIcon("", theme.MoveDownIcon(), func() { p.pan(0, 1) }),
+		widget.NewButtonWithIcon("", theme.NavigateNextIcon(), func() { p.pan(1, 0) }),
+		widget.NewButtonWithIcon("", theme.ZoomOutIcon(), func() { p.zoomBy(-1) }),
+		widget.NewButtonWithIcon("", theme.ZoomInIcon(), func() { p.zoomBy(1) }),
+	)
+
+	return widget.NewSimpleRenderer(container.NewBorder(
+		nil, controls, nil, nil,
+		container.NewStack(p.mapWidget, p.overlay),
+	))
+}
+
+// MinSize gives the map enough room to pick a location comfortably
+func (p *LocationPicker) MinSize() fyne.Size {
+	return p.BaseWidget.MinSize().Max(fyne.NewSize(300, 300))
+}
+
+func (p *LocationPicker) pan(dx, dy int) {
+	p.x += dx
+	p.y += dy
+	for ; dx > 0; dx-- {
+		p.mapWidget.PanEast()
+	}
+	for ; dx < 0; dx++ {
+		p.mapWidget.PanWest()
+	}
+	for ; dy > 0; dy-- {
+		p.mapWidget.PanSouth()
+	}
+	for ; dy < 0; dy++ {
+		p.mapWidget.PanNorth()
+	}
+	p.overlay.Refresh()
+}
+
+func (p *LocationPicker) zoomBy(delta int) {
+	center := p.toLatLon(p.overlay.Size().Width/2, p.overlay.Size().Height/2)
+	if p.overlay.Size().IsZero() {
+		center = DefaultMapCenter
+		if p.pin != nil {
+			center = *p.pin
+		}
+	}
+	p.centerOn(center, p.zoom+delta)
+}
+
+// centerOn moves the map so the given location is near the middle
+func (p *LocationPicker) centerOn(location LatLon, zoom int) {
+	if zoom < 1 {
+		zoom = 1
+	} else if zoom > 19 {
+		zoom = 19
+	}
+
+	// Reset to the origin before applying the new offsets
+	p.mapWidget.Zoom(0)
+	p.zoom, p.x, p.y = 0, 0, 0
+	p.mapWidget.Zoom(zoom)
+	p.zoom = zoom
+
+	tx, ty := tileCoords(location, zoom)
+	// The tile right of the middle is drawn at the centre of the widget
+	mid := p.midTile()
+	p.pan(int(math.Floor(tx-1))-mid, int(math.Floor(ty-1))-mid)
+}
+
+// midTile mirrors the map widget's index of the tile drawn near the centre
+func (p *LocationPicker) midTile() int {
+	return int(float32(int(1)<<p.zoom)/2 - 0.5)
+}
+
+// origin returns where the tile at the mirrored offset is drawn
+func (p *LocationPicker) origin() (float32, float32) {
+	size := p.overlay.Size()
+	ox := (size.Width - 2*mapTileSize) / 2
+	oy := (size.Height - 2*mapTileSize) / 2
+	if p.zoom == 0 {
+		ox += mapTileSize / 2
+		oy += mapTileSize / 2
+	}
+	return ox, oy
+}
+
+// toPosition projects a coordinate onto the overlay
+func (p *LocationPicker) toPosition(location LatLon) fyne.Position {
+	tx, ty := tileCoords(location, p.zoom)
+	ox, oy := p.origin()
+	mid := p.midTile()
+	return fyne.NewPos(
+		ox+float32(tx-float64(p.x+mid))*mapTileSize,
+		oy+float32(ty-float64(p.y+mid))*mapTileSize,
+	)
+}
+
+// toLatLon converts an overlay position back to a coordinate
+func (p *LocationPicker) toLatLon(px, py float32) LatLon {
+	ox, oy := p.origin()
+	mid := p.midTile()
+	tx := float64(p.x+mid) + float64(px-ox)/mapTileSize
+	ty := float64(p.y+mid) + float64(py-oy)/mapTileSize
+	return fromTileCoords(tx, ty, p.zoom)
+}
+
+// unitsPerKm returns how many canvas units a kilometre spans at the pin latitude
+func (p *LocationPicker) unitsPerKm(lat float64) float32 {
+	metresPerUnit := 156543.03392 * math.Cos(lat*math.Pi/180) / float64(int(1)<<p.zoom)
+	return float32(1000 / metresPerUnit)
+}
+
+// tileCoords converts a coordinate to fractional Web Mercator tile coordinates
+func tileCoords(location LatLon, zoom int) (float64, float64) {
+	n := float64(int(1) << zoom)
+	latRad := location.Lat * math.Pi / 180
+	x := (location.Lon + 180) / 360 * n
+	y := (1 - math.Log(math.Tan(latRad)+1/math.Cos(latRad))/math.Pi) / 2 * n
+	return x, y
+}
+
+// fromTileCoords converts fractional Web Mercator tile coordinates to a coordinate
+func fromTileCoords(x, y float64, zoom int) LatLon {
+	n := float64(int(1) << zoom)
+	lon := x/n*360 - 180
+	lat := math.Atan(math.Sinh(math.Pi*(1-2*y/n))) * 180 / math.Pi
+	return LatLon{Lat: lat, Lon: lon}
+}
+
+// pickerOverlay draws the pin and radius and receives taps on the map
+type pickerOverlay struct {
+	widget.BaseWidget
+	picker *LocationPicker
+}
+
+func newPickerOverlay(picker *LocationPicker) *pickerOverlay {
+	o := &pickerOverlay{picker: picker}
+	o.ExtendBaseWidget(o)
+	return o
+}
+
+// Tapped drops the pin where the map was tapped
+func (o *pickerOverlay) Tapped(e *fyne.PointEvent) {
+	location := o.picker.toLatLon(e.Position.X, e.Position.Y)
+	o.picker.pin = &location
+	o.Refresh()
+	if o.picker.OnChanged != nil {
+		o.picker.OnChanged(location)
+	}
+}
+
+func (o *pickerOverlay) CreateRenderer() fyne.WidgetRenderer {
+	area := canvas.NewCircle(color.Transparent)
+	area.StrokeWidth = 2
+	marker := canvas.NewText("📍", color.Black)
+	marker.TextSize = theme.Size(skilltheme.SizeNameDisplayText)
+	r := &pickerOverlayRenderer{overlay: o, area: area, marker: marker}
+	r.Refresh()
+	return r
+}
+
+type pickerOverlayRenderer struct {
+	overlay *pickerOverlay
+	area    *canvas.Circle
+	marker  *canvas.Text
+}
+
+func (r *pickerOverlayRenderer) Layout(_ fyne.Size) {
+	p := r.overlay.picker
+	if p.pin == nil {
+		r.marker.Hide()
+		r.area.Hide()
+		return
+	}
+
+	pos := p.toPosition(*p.pin)
+	markerSize := r.marker.MinSize()
+	// The tip of the pin points at the location
+	r.marker.Move(fyne.NewPos(pos.X-markerSize.Width/2, pos.Y-markerSize.Height))
+	r.marker.Resize(markerSize)
+	r.marker.Show()
+
+	if p.radiusKm <= 0 {
+		r.area.Hide()
+		return
+	}
+	radius := float32(p.radiusKm) * p.unitsPerKm(p.pin.Lat)
+	r.area.Move(fyne.NewPos(pos.X-radius, pos.Y-radius))
+	r.area.Resize(fyne.NewSize(radius*2, radius*2))
+	r.area.Show()
+}
+
+func (r *pickerOverlayRenderer) MinSize() fyne.Size {
+	return fyne.NewSize(0, 0)
+}
+
+func (r *pickerOverlayRenderer) Refresh() {
+	primary := theme.Color(theme.ColorNamePrimary)
+	pr, pg, pb, _ := primary.RGBA()
+	r.area.FillColor = color.NRGBA{R: uint8(pr >> 8), G: uint8(pg >> 8), B: uint8(pb >> 8), A: 0x40}
+	r.area.StrokeColor = primary
+	r.Layout(r.overlay.Size())
+	canvas.Refresh(r.area)
+	canvas.Refresh(r.marker)
+}
+
+func (r *pickerOverlayRenderer) Objects() []fyne.CanvasObject {
+	return []fyne.CanvasObject{r.area, r.marker}
+}
+
+func (r *pickerOverlayRenderer) Destroy() {}
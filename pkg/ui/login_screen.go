@@ -8,58 +8,73 @@ import (
 	"fyne.io/fyne/v2/container"
 	"fyne.io/fyne/v2/layout"
 	"fyne.io/fyne/v2/widget"
+
+	"skillDar/pkg/i18n"
 )
 
 // CreateLoginScreen builds the login/welcome screen
 func CreateLoginScreen(state AppState) fyne.CanvasObject {
-	title := widget.NewLabel("Welcome to SkillKonnect")
+	title := newLabel(i18n.T("Welcome to SkillKonnect"))
 	title.Alignment = fyne.TextAlignCenter
 	title.TextStyle = fyne.TextStyle{Bold: true}
 
-	subtitle := widget.NewLabel("Connect skills, build networks")
+	subtitle := newLabel(i18n.T("Connect skills, build networks"))
 	subtitle.Alignment = fyne.TextAlignCenter
 
 	emailEntry := widget.NewEntry()
-	emailEntry.SetPlaceHolder("Email or Username")
+	emailEntry.SetPlaceHolder(i18n.T("Email or Username"))
 
 	passwordEntry := widget.NewPasswordEntry()
-	passwordEntry.SetPlaceHolder("Password")
+	passwordEntry.SetPlaceHolder(i18n.T("Password"))
 
-	loginBtn := widget.NewButton("Login", func() {
+	var loginBtn *widget.Button
+	var login func()
+	login = func() {
+		if loginBtn.Disabled() {
+			return // Already checking, e.g. Retry tapped meanwhile
+		}
 		email := emailEntry.Text
 		password := passwordEntry.Text
 		if email == "" || password == "" {
-			fmt.Println("Please fill in all fields")
-			state.ShowConnectionError(StatusNoInternet, "Please fill in all fields")
-		} else {
-			// Simulate API call - check connection first
-			apiConfig := DefaultAPIConfig()
+			state.Notifications().Show(Notification{
+				Severity: SeverityWarning,
+				Message:  i18n.T("Please fill in all fields"),
+			})
+			return
+		}
 
-			// Example: Check connection before login
+		// Simulate API call - check connection first, off the UI thread
+		apiConfig := DefaultAPIConfig()
+		loginBtn.Disable()
+		go func() {
 			isConnected, status, message := CheckConnection(apiConfig)
-
-			if !isConnected {
-				// Show connection error
-				state.ShowConnectionError(status, message)
-				return
-			}
-
-			// Simulate successful login
-			fmt.Println("Logged in as:", email)
-			state.HideConnectionError()
-
-			// Navigate to main screen
-			state.ShowScreen("main")
-		}
-	})
+			fyne.Do(func() {
+				loginBtn.Enable()
+				if !isConnected {
+					// Show connection error, the user can try again from it
+					notification := status.Notification(message)
+					notification.Action = &NotificationAction{Label: i18n.T("Retry"), OnTapped: login}
+					state.Notifications().Show(notification)
+					return
+				}
+
+				// Simulate successful login
+				state.HideConnectionError()
+
+				// Navigate to main screen
+				state.CompleteLogin()
+			})
+		}()
+	}
+	loginBtn = widget.NewButton(i18n.T("Login"), func() { login() })
 	loginBtn.Importance = widget.HighImportance
 
 	// Divider
-	orLabel := widget.NewLabel("────── OR ──────")
+	orLabel := newLabel(i18n.T("────── OR ──────"))
 	orLabel.Alignment = fyne.TextAlignCenter
 
 	// Facebook login button
-	facebookBtn := widget.NewButton("Continue with Facebook", func() {
+	facebookBtn := widget.NewButton(i18n.T("Continue with Facebook"), func() {
 		fmt.Println("Opening Facebook OAuth...")
 		// Facebook OAuth URL (replace with your app credentials)
 		clientID := "YOUR_FACEBOOK_APP_ID"
@@ -76,11 +91,11 @@ func CreateLoginScreen(state AppState) fyne.CanvasObject {
 
 		// TODO: Set up callback server to receive the auth code
 		// For now, navigate to main screen for testing
-		state.ShowScreen("main")
+		state.CompleteLogin()
 	})
 
 	// Google login button
-	googleBtn := widget.NewButton("Continue with Google", func() {
+	googleBtn := widget.NewButton(i18n.T("Continue with Google"), func() {
 		fmt.Println("Opening Google OAuth...")
 		// Google OAuth URL (replace with your app credentials)
 		clientID := "YOUR_GOOGLE_CLIENT_ID"
@@ -97,7 +112,7 @@ func CreateLoginScreen(state AppState) fyne.CanvasObject {
 
 		// TODO: Set up callback server to receive the auth code
 		// For now, navigate to main screen for testing
-		state.ShowScreen("main")
+		state.CompleteLogin()
 	})
 
 	content := container.NewVBox(
@@ -3,6 +3,11 @@ package ui
 import (
 	"fmt"
 
+	"skillDar/pkg/assets"
+	"skillDar/pkg/i18n"
+	"skillDar/pkg/money"
+	"skillDar/pkg/router"
+	"skillDar/pkg/search"
 	skilltheme "skillDar/pkg/theme"
 
 	"fyne.io/fyne/v2"
@@ -13,86 +18,230 @@ import (
 	"fyne.io/fyne/v2/widget"
 )
 
-// CreateMainScreen builds the main app screen with bottom navigation
+// navTab describes one entry of the bottom navigation bar
+type navTab struct {
+	label string                                 // icon and translated label, e.g. "🏠\nHome"
+	build func(state AppState) fyne.CanvasObject // builds the tab content
+}
+
+// clientTabs returns the bottom navigation tabs for clients
+func clientTabs() []navTab {
+	return []navTab{
+		{"🏠\n" + i18n.T("Home"), createClientHomeContent},
+		{"📋\n" + i18n.T("Orders"), createOrdersContent},
+		{"💬\n" + i18n.T("Chat"), createChatContent},
+		{"👤\n" + i18n.T("Profile"), createProfileContent},
+	}
+}
+
+// workerTabs returns the bottom navigation tabs for workers
+func workerTabs() []navTab {
+	return []navTab{
+		{"📊\n" + i18n.T("Dashboard"), createWorkerHomeContent},
+		{"🧰\n" + i18n.T("Jobs"), createWorkerJobsContent},
+		{"💬\n" + i18n.T("Chat"), createChatContent},
+		{"👤\n" + i18n.T("Profile"), createProfileContent},
+	}
+}
+
+// mainScreen is the home screen with bottom navigation.
+// Tab contents are built on first use and kept, and the screen remembers
+// the selected tab and scroll positions for the back stack. Tab contents
+// that implement ScreenLifecycle are told when they are shown, e.g. to
+// reload their data, and destroyed with the screen.
+type mainScreen struct {
+	widget.BaseWidget
+
+	state    AppState
+	tabs     []navTab
+	views    []fyne.CanvasObject // Built tab contents, nil until first shown
+	offsets  []fyne.Position     // Scroll position of each tab
+	buttons  []*skilltheme.NavButton
+	content  *fyne.Container
+	scroll   *container.Scroll
+	nav      fyne.CanvasObject
+	selected int
+	visible  bool // The main screen is the current screen
+}
+
+// CreateMainScreen builds the main app screen with bottom navigation.
+// The tabs depend on the current user role.
 func CreateMainScreen(state AppState) fyne.CanvasObject {
+	m := &mainScreen{state: state, tabs: clientTabs()}
+	if state.GetUserRole() == "worker" {
+		m.tabs = workerTabs()
+	}
+	m.views = make([]fyne.CanvasObject, len(m.tabs))
+	m.offsets = make([]fyne.Position, len(m.tabs))
+
 	// Content container that will change based on selected tab
-	currentContent := container.NewVBox(createClientHomeContent(state))
+	m.content = container.NewVBox()
+	m.showTab(0)
+	m.scroll = container.NewScroll(m.content)
 
 	// Bottom navigation bar
-	bottomNav := createBottomNavigationBar(state, currentContent)
+	m.nav, m.buttons = createBottomNavigationBar(m.tabs, m.SelectTab)
+
+	m.ExtendBaseWidget(m)
+	return m
+}
+
+// tabView returns the content of tab i, building it on first use.
+// Built tabs stay in the content, hidden while not selected, so theme
+// changes reach them too.
+func (m *mainScreen) tabView(i int) fyne.CanvasObject {
+	if m.views[i] == nil {
+		m.views[i] = m.tabs[i].build(m.state)
+		m.content.Add(m.views[i])
+	}
+	return m.views[i]
+}
+
+// showTab makes tab i the only visible tab content
+func (m *mainScreen) showTab(i int) {
+	if m.visible && m.views[m.selected] != nil && m.selected != i {
+		if s, ok := m.views[m.selected].(ScreenLifecycle); ok {
+			s.OnHide()
+		}
+	}
+	selected := m.tabView(i)
+	if s, ok := selected.(ScreenLifecycle); ok && m.visible && m.selected != i {
+		s.OnShow()
+	}
+	for _, view := range m.views {
+		if view == nil {
+			continue
+		}
+		if view == selected {
+			view.Show()
+		} else {
+			view.Hide()
+		}
+	}
+	m.content.Refresh()
+}
+
+// SelectTab shows the tab at index i.
+// Tapping the selected tab again scrolls it back to the top.
+func (m *mainScreen) SelectTab(i int) {
+	if i < 0 || i >= len(m.tabs) {
+		return
+	}
+	if i == m.selected {
+		m.scroll.ScrollToTop()
+		return
+	}
+	m.offsets[m.selected] = m.scroll.Offset
+	m.showTab(i)
+	m.selected = i
+	for j, btn := range m.buttons {
+		btn.SetActive(j == i)
+	}
+	m.scroll.Offset = m.offsets[i]
+	m.scroll.Refresh()
+}
 
+// OnShow implements ScreenLifecycle. The selected tab is told it is shown,
+// so it can reload data changed on other screens while keeping its state,
+// e.g. the search text.
+func (m *mainScreen) OnShow() {
+	m.visible = true
+	if s, ok := m.views[m.selected].(ScreenLifecycle); ok {
+		s.OnShow()
+	}
+}
+
+// OnHide implements ScreenLifecycle
+func (m *mainScreen) OnHide() {
+	m.visible = false
+	if s, ok := m.views[m.selected].(ScreenLifecycle); ok {
+		s.OnHide()
+	}
+}
+
+// OnDestroy implements ScreenLifecycle, tab contents that are screens
+// release their store listeners
+func (m *mainScreen) OnDestroy() {
+	for _, view := range m.views {
+		if s, ok := view.(ScreenLifecycle); ok {
+			s.OnDestroy()
+		}
+	}
+}
+
+// ViewState implements ViewStateful
+func (m *mainScreen) ViewState() router.ViewState {
+	return router.ViewState{Tab: m.selected, ScrollX: m.scroll.Offset.X, ScrollY: m.scroll.Offset.Y}
+}
+
+// RestoreViewState implements ViewStateful
+func (m *mainScreen) RestoreViewState(view router.ViewState) {
+	m.SelectTab(view.Tab)
+	m.scroll.Offset = fyne.NewPos(view.ScrollX, view.ScrollY)
+	m.scroll.Refresh()
+}
+
+func (m *mainScreen) CreateRenderer() fyne.WidgetRenderer {
 	// Main layout with bottom navigation
-	mainLayout := container.NewBorder(
-		nil,                                 // top
-		bottomNav,                           // bottom
-		nil,                                 // left
-		nil,                                 // right
-		container.NewScroll(currentContent), // center
-	)
+	return widget.NewSimpleRenderer(container.NewBorder(
+		nil,      // top
+		m.nav,    // bottom
+		nil,      // left
+		nil,      // right
+		m.scroll, // center
+	))
+}
+
+// themeToggleButton switches between light and dark theme. Its text and
+// icon are looked up on every refresh, so they stay right when the theme
+// changes elsewhere, e.g. in the settings or the system.
+type themeToggleButton struct {
+	widget.Button
+	state AppState
+}
+
+func newThemeToggleButton(state AppState) *themeToggleButton {
+	b := &themeToggleButton{state: state}
+	b.Alignment = buttonAlignLeading()
+	b.OnTapped = state.ToggleTheme
+	b.ExtendBaseWidget(b)
+	b.update()
+	return b
+}
+
+// update sets the text and icon for the current theme
+func (b *themeToggleButton) update() {
+	b.Text = i18n.T("Dark Mode")
+	if b.state.IsDarkTheme() {
+		b.Text = i18n.T("Light Mode")
+	}
+	b.Icon = b.state.GetImage(assets.ThemeToggle)
+}
 
-	return mainLayout
+func (b *themeToggleButton) Refresh() {
+	b.update()
+	b.Button.Refresh()
 }
 
-// createBottomNavigationBar creates the bottom navigation menu
-func createBottomNavigationBar(state AppState, contentContainer *fyne.Container) fyne.CanvasObject {
+// createBottomNavigationBar creates the bottom navigation menu,
+// onSelect is called with the index of the tapped tab
+func createBottomNavigationBar(tabs []navTab, onSelect func(int)) (fyne.CanvasObject, []*skilltheme.NavButton) {
 	// Create a theme-aware navbar background from theme package
 	navBg := skilltheme.NewThemedNavBar()
 
 	// Create navigation buttons using custom NavButton
-	homeBtn := skilltheme.NewNavButton("🏠\nHome", true, nil)
-	ordersBtn := skilltheme.NewNavButton("📋\nOrders", false, nil)
-	chatBtn := skilltheme.NewNavButton("💬\nChat", false, nil)
-	profileBtn := skilltheme.NewNavButton("👤\nProfile", false, nil)
-
-	// Set up button tap handlers
-	homeBtn.OnTapped = func() {
-		homeBtn.SetActive(true)
-		ordersBtn.SetActive(false)
-		chatBtn.SetActive(false)
-		profileBtn.SetActive(false)
-		contentContainer.Objects = []fyne.CanvasObject{createClientHomeContent(state)}
-		contentContainer.Refresh()
-	}
-
-	ordersBtn.OnTapped = func() {
-		homeBtn.SetActive(false)
-		ordersBtn.SetActive(true)
-		chatBtn.SetActive(false)
-		profileBtn.SetActive(false)
-		contentContainer.Objects = []fyne.CanvasObject{createOrdersContent(state)}
-		contentContainer.Refresh()
-	}
-
-	chatBtn.OnTapped = func() {
-		homeBtn.SetActive(false)
-		ordersBtn.SetActive(false)
-		chatBtn.SetActive(true)
-		profileBtn.SetActive(false)
-		contentContainer.Objects = []fyne.CanvasObject{createChatContent(state)}
-		contentContainer.Refresh()
-	}
-
-	profileBtn.OnTapped = func() {
-		homeBtn.SetActive(false)
-		ordersBtn.SetActive(false)
-		chatBtn.SetActive(false)
-		profileBtn.SetActive(true)
-		contentContainer.Objects = []fyne.CanvasObject{createProfileContent(state)}
-		contentContainer.Refresh()
-	}
-
-	// Create navigation bar layout
-	navItems := container.NewHBox(
-		layout.NewSpacer(),
-		homeBtn,
-		layout.NewSpacer(),
-		ordersBtn,
-		layout.NewSpacer(),
-		chatBtn,
-		layout.NewSpacer(),
-		profileBtn,
-		layout.NewSpacer(),
-	)
+	buttons := make([]*skilltheme.NavButton, len(tabs))
+	for i, tab := range tabs {
+		selected := i
+		buttons[i] = skilltheme.NewNavButton(tab.label, i == 0, func() { onSelect(selected) })
+	}
+
+	// Create navigation bar layout, the first tab at the reading start
+	navItems := newRow(layout.NewSpacer())
+	for _, btn := range mirrored(navObjects(buttons)...) {
+		navItems.Add(btn)
+		navItems.Add(layout.NewSpacer())
+	}
 
 	// Stack the background and items
 	navBarContent := container.NewStack(navBg, navItems)
@@ -100,38 +249,46 @@ func createBottomNavigationBar(state AppState, contentContainer *fyne.Container)
 	// Wrap in a fixed height container (adjust the height value as needed)
 	fixedNav := skilltheme.NewFixedHeightContainer(40, navBarContent) // Change 50 to your desired height
 
-	return fixedNav
+	return fixedNav, buttons
+}
+
+// navObjects returns the navigation buttons as canvas objects
+func navObjects(buttons []*skilltheme.NavButton) []fyne.CanvasObject {
+	objects := make([]fyne.CanvasObject, len(buttons))
+	for i, btn := range buttons {
+		objects[i] = btn
+	}
+	return objects
 }
 
 // createClientHomeContent creates the home content for clients
 func createClientHomeContent(state AppState) fyne.CanvasObject {
-	title := widget.NewLabel("Available Workers")
+	title := newLabel(i18n.T("Available Workers"))
 	title.TextStyle = fyne.TextStyle{Bold: true}
 	title.Alignment = fyne.TextAlignCenter
 
 	// Search bar
 	searchEntry := widget.NewEntry()
-	searchEntry.SetPlaceHolder("Search for workers...")
-	searchEntry.OnChanged = func(searchText string) {
-		fmt.Println("Search text changed:", searchText)
-		// TODO: Implement search filtering
-	}
+	searchEntry.SetPlaceHolder(i18n.T("Search for workers..."))
+	searchEntry.ActionItem = widget.NewIcon(theme.SearchIcon())
 
 	// Professional categories
-	categoriesLabel := widget.NewLabel("Professional Categories")
+	categoriesLabel := newLabel(i18n.T("Professional Categories"))
 	categoriesLabel.TextStyle = fyne.TextStyle{Bold: true}
 
-	// Create category buttons with icons
-	plumbingCard := createCategoryButton(state, "plumbing", "Plumbing")
-	electricityCard := createCategoryButton(state, "electricity", "Electricity")
-	paintingCard := createCategoryButton(state, "painting", "Painting")
-	acFixingCard := createCategoryButton(state, "acFixing", "AC Fixing")
-	homeCleaningCard := createCategoryButton(state, "homeCleaning", "Home Cleaning")
-	smallRepairsCard := createCategoryButton(state, "smallRepairs", "Small Repairs")
-	furnitureCard := createCategoryButton(state, "furnitureAssembly", "Furniture Assembly")
-	waterLeakCard := createCategoryButton(state, "waterLeakage", "Water Leakage")
-	applianceCard := createCategoryButton(state, "applianceRepair", "Appliance Repair")
-	locksmithCard := createCategoryButton(state, "locksmith", "Locksmiths")
+	// Create category buttons with icons, tapping one shows its workers
+	var selectCategory func(name string)
+	onCategory := func(name string) { selectCategory(name) }
+	plumbingCard := createCategoryButton(state, assets.Plumbing, "Plumbing", onCategory)
+	electricityCard := createCategoryButton(state, assets.Electricity, "Electricity", onCategory)
+	paintingCard := createCategoryButton(state, assets.Painting, "Painting", onCategory)
+	acFixingCard := createCategoryButton(state, assets.AirConditioning, "AC Fixing", onCategory)
+	homeCleaningCard := createCategoryButton(state, assets.HomeCleaning, "Home Cleaning", onCategory)
+	smallRepairsCard := createCategoryButton(state, assets.SmallRepairs, "Small Repairs", onCategory)
+	furnitureCard := createCategoryButton(state, assets.FurnitureAssembly, "Furniture Assembly", onCategory)
+	waterLeakCard := createCategoryButton(state, assets.WaterLeakage, "Water Leakage", onCategory)
+	applianceCard := createCategoryButton(state, assets.ApplianceRepair, "Appliance Repair", onCategory)
+	locksmithCard := createCategoryButton(state, assets.Locksmith, "Locksmiths", onCategory)
 
 	// Use GridWrap with compact size for mobile
 	categoriesGrid := container.NewGridWrap(
@@ -153,46 +310,32 @@ func createClientHomeContent(state AppState) fyne.CanvasObject {
 	separator1 := widget.NewSeparator()
 
 	// Available workers
-	workersLabel := widget.NewLabel("Available Workers Near You (5)")
+	workersLabel := newLabel("") // Set by showWorkers
 	workersLabel.TextStyle = fyne.TextStyle{Bold: true}
 
 	// Dummy worker data - start with first 5 workers
-	allWorkers := []struct {
-		name       string
-		profession string
-		rating     string
-		distance   string
-		reviews    string
-		price      string
-		available  bool
-	}{
-		{"Mohamed Hassan", "Plumber", "4.9", "0.8 km", "127", "180 TND/hr", true},
-		{"Ahmed El-Sayed", "Electrician", "4.8", "1.2 km", "98", "200 TND/hr", true},
-		{"Hossam Abid", "Painter", "4.5", "2.1 km", "55", "150 TND/hr", false},
-		{"Youssef Mansour", "AC Technician", "4.7", "1.5 km", "89", "190 TND/hr", true},
-		{"Karim Saidi", "Cleaner", "4.6", "0.5 km", "112", "120 TND/hr", true},
-		// Next batch
-		{"Ali Ben Salem", "Plumber", "4.8", "3.2 km", "145", "170 TND/hr", true},
-		{"Sofiane Gharbi", "Electrician", "4.9", "2.8 km", "203", "210 TND/hr", false},
-		{"Mehdi Trabelsi", "Carpenter", "4.7", "1.9 km", "78", "165 TND/hr", true},
-		{"Nabil Chebbi", "Locksmith", "4.6", "2.5 km", "92", "140 TND/hr", true},
-		{"Rami Bouazizi", "Painter", "4.5", "3.0 km", "67", "155 TND/hr", true},
-		// Third batch
-		{"Farid Jelassi", "AC Technician", "4.8", "1.8 km", "134", "195 TND/hr", true},
-		{"Tarek Maatoug", "Plumber", "4.7", "2.2 km", "101", "175 TND/hr", false},
-		{"Walid Hamdi", "Electrician", "4.9", "0.9 km", "187", "205 TND/hr", true},
-		{"Sami Ayari", "Cleaner", "4.6", "1.7 km", "88", "125 TND/hr", true},
-		{"Bassem Jribi", "Carpenter", "4.5", "3.5 km", "72", "160 TND/hr", true},
-	}
+	allWorkers := sampleWorkers()
+	workers := allWorkers // Workers matching the search, shown 5 at a time
+	category := ""        // Selected category, all categories when empty
 
 	currentDisplayCount := 5
 	isLoading := false
 
-	// Workers container - start with first 5
+	// Workers container, filled with the first 5 once the avatar viewport exists
 	workersContainer := container.NewVBox()
-	for i := 0; i < currentDisplayCount && i < len(allWorkers); i++ {
-		w := allWorkers[i]
-		workersContainer.Add(createSimpleWorkerCard(state, w.name, w.profession, w.rating, w.distance, w.reviews, w.price, w.available))
+	var viewport *ImageViewport
+	cards := &Subscriptions{} // Listeners of the cards in the list
+
+	noResults := newLabel(i18n.T("No workers match your search"))
+	noResults.Alignment = fyne.TextAlignCenter
+	noResults.Wrapping = fyne.TextWrapWord
+
+	updateWorkersLabel := func() {
+		if category != "" {
+			workersLabel.SetText(i18n.T("%s near you (%d)", i18n.T(category), currentDisplayCount))
+			return
+		}
+		workersLabel.SetText(i18n.T("Available Workers Near You (%d)", currentDisplayCount))
 	}
 
 	// Make workers scrollable with minimum height
@@ -202,25 +345,24 @@ func createClientHomeContent(state AppState) fyne.CanvasObject {
 		fmt.Printf("Workers scrolled to position: X=%.2f, Y=%.2f\n", pos.X, pos.Y)
 
 		// Check if we're near the bottom (Y position > 40 means scrolled down significantly)
-		if pos.Y > 40 && !isLoading && currentDisplayCount < len(allWorkers) {
+		if pos.Y > 40 && !isLoading && currentDisplayCount < len(workers) {
 			isLoading = true
 			fmt.Println(">>> Loading more workers...")
 
 			// Load next 5 workers
 			oldCount := currentDisplayCount
 			currentDisplayCount += 5
-			if currentDisplayCount > len(allWorkers) {
-				currentDisplayCount = len(allWorkers)
+			if currentDisplayCount > len(workers) {
+				currentDisplayCount = len(workers)
 			}
 
 			// Add new workers to container
 			for i := oldCount; i < currentDisplayCount; i++ {
-				w := allWorkers[i]
-				workersContainer.Add(createSimpleWorkerCard(state, w.name, w.profession, w.rating, w.distance, w.reviews, w.price, w.available))
+				workersContainer.Add(createSimpleWorkerCard(state, workers[i], viewport, cards))
 			}
 
 			// Update label
-			workersLabel.SetText(fmt.Sprintf("Available Workers Near You (%d)", currentDisplayCount))
+			updateWorkersLabel()
 			workersContainer.Refresh()
 
 			fmt.Printf(">>> Loaded %d more workers. Total: %d\n", currentDisplayCount-oldCount, currentDisplayCount)
@@ -228,8 +370,41 @@ func createClientHomeContent(state AppState) fyne.CanvasObject {
 		}
 	}
 
+	// showWorkers fills the list with the first workers matching the search
+	// text and the selected category
+	showWorkers := func() {
+		workers = filterWorkers(allWorkers, searchEntry.Text, category)
+		currentDisplayCount = min(5, len(workers))
+		viewport.Clear()
+		cards.Release()
+		workersContainer.RemoveAll()
+		for i := 0; i < currentDisplayCount; i++ {
+			workersContainer.Add(createSimpleWorkerCard(state, workers[i], viewport, cards))
+		}
+		if len(workers) == 0 {
+			workersContainer.Add(noResults)
+		}
+		updateWorkersLabel()
+		workersScroll.ScrollToTop()
+	}
+
+	// Avatars load while their card is in view
+	viewport = NewImageViewport(workersScroll)
+	showWorkers()
+
+	searchEntry.OnChanged = func(string) { showWorkers() }
+	// Tapping the selected category again shows all workers
+	selectCategory = func(name string) {
+		if category == name {
+			category = ""
+		} else {
+			category = name
+		}
+		showWorkers()
+	}
+
 	// Combine everything in a VBox
-	content := container.NewVBox(
+	screen := NewScreen(container.NewVBox(
 		title,
 		searchEntry,
 		categoriesLabel,
@@ -237,35 +412,18 @@ func createClientHomeContent(state AppState) fyne.CanvasObject {
 		separator1,
 		workersLabel,
 		workersScroll,
-	)
-
-	return content
-}
-
-// createOrdersContent creates the orders/bookings content
-func createOrdersContent(state AppState) fyne.CanvasObject {
-	title := widget.NewLabel("My Orders")
-	title.TextStyle = fyne.TextStyle{Bold: true}
-	title.Alignment = fyne.TextAlignCenter
-
-	noOrders := widget.NewLabel("No orders yet")
-	noOrders.Alignment = fyne.TextAlignCenter
-
-	return container.NewVBox(
-		layout.NewSpacer(),
-		title,
-		noOrders,
-		layout.NewSpacer(),
-	)
+	))
+	screen.DestroyFunc = cards.Release
+	return screen
 }
 
 // createChatContent creates the chat/messages content
 func createChatContent(state AppState) fyne.CanvasObject {
-	title := widget.NewLabel("Messages")
+	title := newLabel(i18n.T("Messages"))
 	title.TextStyle = fyne.TextStyle{Bold: true}
 	title.Alignment = fyne.TextAlignCenter
 
-	noMessages := widget.NewLabel("No messages yet")
+	noMessages := newLabel(i18n.T("No messages yet"))
 	noMessages.Alignment = fyne.TextAlignCenter
 
 	return container.NewVBox(
@@ -278,161 +436,244 @@ func createChatContent(state AppState) fyne.CanvasObject {
 
 // createProfileContent creates the user profile content
 func createProfileContent(state AppState) fyne.CanvasObject {
-	title := widget.NewLabel("My Profile")
+	title := newLabel(i18n.T("My Profile"))
 	title.TextStyle = fyne.TextStyle{Bold: true}
 	title.Alignment = fyne.TextAlignCenter
 
-	// Profile picture placeholder
-	profileCircle := canvas.NewCircle(theme.PrimaryColor())
-	profileCircle.Resize(fyne.NewSize(100, 100))
-	profilePic := container.NewCenter(profileCircle)
+	// Profile picture
+	subs := &Subscriptions{}
+	profilePic := container.NewCenter(newUserAvatar(state, 100, subs))
 
-	// User info
-	nameLabel := widget.NewLabel("John Doe")
+	// User info, it follows profile edits
+	nameLabel := newLabel("")
 	nameLabel.TextStyle = fyne.TextStyle{Bold: true}
-	nameLabel.Alignment = fyne.TextAlignLeading
-
-	emailLabel := widget.NewLabel("john.doe@example.com")
-	emailLabel.Alignment = fyne.TextAlignLeading
-
-	phoneLabel := widget.NewLabel("+216 12 345 678")
-	phoneLabel.Alignment = fyne.TextAlignLeading
+	nameLabel.Alignment = textAlignLeading()
+
+	emailLabel := newLabel("")
+	emailLabel.Alignment = textAlignLeading()
+
+	phoneLabel := newLabel("")
+	phoneLabel.Alignment = textAlignLeading()
+
+	// Until the profile is loaded the tab says so, or offers to try again
+	statusLabel := newLabel("")
+	statusLabel.Alignment = textAlignLeading()
+	retryBtn := widget.NewButton(i18n.T("Retry"), nil)
+	retryBtn.Alignment = buttonAlignLeading()
+
+	showProfile := func() {
+		store := state.Profile()
+		profile := store.Profile()
+		nameLabel.SetText(profile.Name)
+		emailLabel.SetText(profile.Email)
+		phoneLabel.SetText(profile.Phone)
+		retryBtn.Hide()
+		statusLabel.Show()
+		switch {
+		case profile.ID != "":
+			statusLabel.Hide()
+		case store.LoadError() != nil:
+			statusLabel.SetText(i18n.T("Could not load your profile"))
+			retryBtn.Show()
+		default:
+			statusLabel.SetText(i18n.T("Loading profile..."))
+		}
+	}
+	retryBtn.OnTapped = func() {
+		state.Profile().Load(func(err error) {
+			if err != nil {
+				state.ShowConnectionError(StatusForError(err))
+			}
+		})
+		showProfile()
+	}
+	showProfile()
+	subs.Add(state.Profile().OnChanged(showProfile))
 
 	// Edit profile button
-	editBtn := widget.NewButton("Edit Profile", func() {
-		state.ShowScreen("edit_profile_client")
+	editBtn := widget.NewButton(i18n.T("Edit Profile"), func() {
+		if state.GetUserRole() == "worker" {
+			state.Navigate(router.To(router.EditProfileWorker))
+			return
+		}
+		state.Navigate(router.To(router.EditProfileClient))
 	})
 	editBtn.Importance = widget.HighImportance
-	editBtn.Alignment = widget.ButtonAlignLeading
+	editBtn.Alignment = buttonAlignLeading()
+
+	// Saved workers
+	savedWorkersBtn := widget.NewButton("♥ "+i18n.T("Saved Workers"), func() {
+		state.Navigate(router.To(router.SavedWorkers))
+	})
+	savedWorkersBtn.Alignment = buttonAlignLeading()
+
+	// Saved addresses for booking
+	addressesBtn := widget.NewButton("📍 "+i18n.T("Saved Addresses"), func() {
+		state.Navigate(router.To(router.Addresses))
+	})
+	addressesBtn.Alignment = buttonAlignLeading()
+
+	// Prepaid wallet for clients
+	walletBtn := widget.NewButton("👛 "+i18n.T("Wallet"), func() {
+		state.Navigate(router.To(router.Wallet))
+	})
+	walletBtn.Alignment = buttonAlignLeading()
+	// Working hours editor for workers
+	workingHoursBtn := widget.NewButton("🗓 "+i18n.T("Working Hours & Days Off"), func() {
+		state.Navigate(router.To(router.Availability))
+	})
+	workingHoursBtn.Alignment = buttonAlignLeading()
+
+	// Identity and certificate verification for workers
+	verificationBtn := widget.NewButton("🪪 "+i18n.T("Verification"), func() {
+		state.Navigate(router.To(router.Verification))
+	})
+	verificationBtn.Alignment = buttonAlignLeading()
+
+	// Earnings, job history and payouts for workers
+	earningsBtn := widget.NewButton("💰 "+i18n.T("Earnings & Payouts"), func() {
+		state.Navigate(router.To(router.Earnings))
+	})
+	earningsBtn.Alignment = buttonAlignLeading()
+
+	if state.GetUserRole() == "worker" {
+		savedWorkersBtn.Hide()
+		addressesBtn.Hide()
+		walletBtn.Hide()
+	} else {
+		workingHoursBtn.Hide()
+		verificationBtn.Hide()
+		earningsBtn.Hide()
+	}
 
 	// Settings options
-	settingsLabel := widget.NewLabel("Settings")
+	settingsLabel := newLabel(i18n.T("Settings"))
 	settingsLabel.TextStyle = fyne.TextStyle{Bold: true}
-	settingsLabel.Alignment = fyne.TextAlignLeading
-
-	// Theme toggle with custom icons (dynamic button)
-	var themeToggle *widget.Button
-	updateThemeButton := func() {
-		if state.IsDarkTheme() {
-			themeToggle.SetText("Light Mode")
-			themeToggle.SetIcon(state.GetImage("lightTheme"))
-			themeToggle.Alignment = widget.ButtonAlignLeading
-		} else {
-			themeToggle.SetText("Dark Mode")
-			themeToggle.SetIcon(state.GetImage("darkTheme"))
-			themeToggle.Alignment = widget.ButtonAlignLeading
-		}
-	}
+	settingsLabel.Alignment = textAlignLeading()
 
-	themeToggle = widget.NewButtonWithIcon("Dark Mode", state.GetImage("darkTheme"), func() {
-		state.ToggleTheme()
-		updateThemeButton()
-	})
-	themeToggle.Alignment = widget.ButtonAlignLeading
-	notificationsBtn := widget.NewButton("Notifications", func() {
-		fmt.Println("Notifications clicked")
+	// Theme toggle with custom icons, it follows theme changes by itself
+	themeToggle := newThemeToggleButton(state)
+
+	// Notifications, language, units and data saver
+	settingsBtn := widget.NewButtonWithIcon(i18n.T("Settings"), theme.SettingsIcon(), func() {
+		state.Navigate(router.To(router.Settings))
 	})
-	notificationsBtn.Alignment = widget.ButtonAlignLeading
-	languageBtn := widget.NewButton("Language", func() {
-		fmt.Println("Language clicked")
+	settingsBtn.Alignment = buttonAlignLeading()
+
+	referralBtn := widget.NewButton("🎁 "+i18n.T("Refer a Friend"), func() {
+		state.Navigate(router.To(router.Referral))
 	})
-	languageBtn.Alignment = widget.ButtonAlignLeading
+	referralBtn.Alignment = buttonAlignLeading()
 
-	helpBtn := widget.NewButton("Help & Support", func() {
-		fmt.Println("Help clicked")
+	helpBtn := widget.NewButton(i18n.T("Help & Support"), func() {
+		showHelp(state)
 	})
-	helpBtn.Alignment = widget.ButtonAlignLeading
+	helpBtn.Alignment = buttonAlignLeading()
 
-	logoutBtn := widget.NewButton("Logout", func() {
-		fmt.Println("Logout clicked")
+	// Role switch rebuilds the screens for the other role
+	roleBtn := widget.NewButton("🧰 "+i18n.T("Switch to Worker Mode"), func() {
+		state.SetUserRole("worker")
 	})
+	if state.GetUserRole() == "worker" {
+		roleBtn.SetText("🏠 " + i18n.T("Switch to Client Mode"))
+		roleBtn.OnTapped = func() {
+			state.SetUserRole("client")
+		}
+	}
+	roleBtn.Alignment = buttonAlignLeading()
+
+	logoutBtn := widget.NewButton(i18n.T("Logout"), state.Logout)
 	logoutBtn.Importance = widget.DangerImportance
-	logoutBtn.Alignment = widget.ButtonAlignLeading
-	return container.NewVBox(
+	logoutBtn.Alignment = buttonAlignLeading()
+	screen := NewScreen(container.NewVBox(
 		title,
 		profilePic,
 		nameLabel,
 		emailLabel,
 		phoneLabel,
+		statusLabel,
+		retryBtn,
 		layout.NewSpacer(),
 		editBtn,
+		savedWorkersBtn,
+		addressesBtn,
+		walletBtn,
+		workingHoursBtn,
+		verificationBtn,
+		earningsBtn,
 		layout.NewSpacer(),
 		settingsLabel,
 		themeToggle,
-		notificationsBtn,
-		languageBtn,
+		settingsBtn,
+		referralBtn,
 		helpBtn,
+		roleBtn,
 		layout.NewSpacer(),
 		logoutBtn,
-	)
+	))
+	screen.DestroyFunc = subs.Release
+	return screen
 }
 
-// createSimpleWorkerCard creates a clickable worker card for clients
-func createSimpleWorkerCard(state AppState, name, profession, rating, distance, reviewCount, price string, available bool) fyne.CanvasObject {
-	// Profile picture placeholder
-	profileCircle := canvas.NewCircle(theme.Color(skilltheme.ColorNameHighlight))
-	profilePic := container.NewStack(profileCircle)
-	profilePic.Resize(fyne.NewSize(50, 50))
+// createSimpleWorkerCard creates a clickable worker card for clients.
+// viewport is optional, with one the avatar only loads while the card is in view.
+// The card follows favorite and avatar changes until subs is released.
+func createSimpleWorkerCard(state AppState, worker WorkerProfile, viewport *ImageViewport, subs *Subscriptions) fyne.CanvasObject {
+	// Profile picture
+	avatar := workerAvatar(state, worker, 50, subs)
+	if viewport != nil {
+		viewport.Add(avatar)
+	}
+	profilePic := container.NewCenter(avatar)
 
-	nameLabel := widget.NewLabel(name)
+	nameLabel := newLabel(worker.Name)
 	nameLabel.TextStyle = fyne.TextStyle{Bold: true}
 
 	// Verified badge
-	verifiedLabel := widget.NewLabel("✓ Verified")
-	verifiedBadge := container.NewHBox(
-		widget.NewLabel(name),
+	verifiedLabel := newRow(widget.NewIcon(state.GetImage(assets.Verified)), newLabel(i18n.T("Verified")))
+	if !worker.Verified {
+		verifiedLabel.Hide()
+	}
+	verifiedBadge := newRow(
+		newLabel(worker.Name),
 		verifiedLabel,
 	)
 
-	professionLabel := widget.NewLabel(profession)
+	professionLabel := newLabel(worker.Profession)
 
-	ratingLabel := widget.NewLabel("⭐ " + rating)
-	reviewLabel := widget.NewLabel("(" + reviewCount + ")")
-	distanceLabel := widget.NewLabel("📍 " + distance)
+	ratingLabel := newLabel("⭐ " + i18n.FormatDecimal(float64(worker.Rating), 1))
+	reviewLabel := newLabel("(" + i18n.FormatNumber(int64(worker.ReviewCount)) + ")")
+	distanceLabel := newRow(widget.NewIcon(state.GetImage(assets.Location)), newLabel(formatDistanceText(worker.Distance, state.Settings().DistanceUnit())))
 
-	priceLabel := widget.NewLabel(price)
+	priceLabel := newLabel(i18n.T("%s/hr", worker.HourlyRate.Compact(money.Locale())))
 	priceLabel.TextStyle = fyne.TextStyle{Bold: true}
 
-	statusLabel := widget.NewLabel("✅ Available")
+	statusLabel := newLabel("✅ " + i18n.T("Available"))
 	statusLabel.Importance = widget.SuccessImportance
-	if !available {
-		statusLabel.Text = "⏰ Busy"
+	if !worker.IsAvailableNow() {
+		statusLabel.Text = "⏰ " + i18n.T("Busy")
 		statusLabel.Importance = widget.WarningImportance
 	}
 
 	info := container.NewVBox(
 		verifiedBadge,
 		professionLabel,
-		container.NewHBox(ratingLabel, reviewLabel, distanceLabel),
+		newRow(ratingLabel, reviewLabel, distanceLabel),
 	)
 
 	rightSide := container.NewVBox(
-		priceLabel,
+		newRow(priceLabel, newFavoriteButton(state, worker, subs)),
 		statusLabel,
 	)
 
-	cardContent := container.NewBorder(
+	cardContent := newBorderRow(
 		nil, nil,
-		container.NewHBox(profilePic, info),
+		newRow(profilePic, info),
 		rightSide,
 	)
 
 	// Create a button that wraps the content
 	btn := widget.NewButton("", func() {
-		// Create worker profile and show screen
-		worker := WorkerProfile{
-			Name:            name,
-			Profession:      profession,
-			Rating:          4.9,
-			ReviewCount:     127,
-			Distance:        distance,
-			HourlyRate:      180,
-			CompletedJobs:   340,
-			YearsExperience: 12,
-			Available:       available,
-			About:           "Professional installation and maintenance of electrical wiring, fixtures, and appliances.",
-			Skills:          []string{"Plumbing", "Repair", "Installation"},
-		}
 		state.ShowWorkerProfile(worker)
 	})
 
@@ -440,14 +681,67 @@ func createSimpleWorkerCard(state AppState, name, profession, rating, distance,
 	return container.NewStack(btn, cardContent)
 }
 
-// createCategoryButton creates a clickable category button with icon image
-func createCategoryButton(state AppState, iconKey, name string) fyne.CanvasObject {
+// filterWorkers returns the workers of the category, all when empty, that
+// match the search text. The text may name the worker, a skill or the
+// category in any language, see search.Default.
+func filterWorkers(workers []WorkerProfile, text, category string) []WorkerProfile {
+	searching := len(search.Words(text)) > 0
+	var found []WorkerProfile
+	for _, w := range workers {
+		workerCategory := search.Default.CategoryOf(w.Profession)
+		if category != "" && workerCategory != category {
+			continue
+		}
+		fields := append([]string{w.Name, w.Profession, i18n.T(w.Profession)}, w.Skills...)
+		if searching && !search.Default.Matches(text, workerCategory, fields...) {
+			continue
+		}
+		found = append(found, w)
+	}
+	return found
+}
+
+// sampleWorkers returns the dummy worker list shown until the workers API is wired
+func sampleWorkers() []WorkerProfile {
+	workers := []WorkerProfile{
+		{ID: "w-001", Name: "Mohamed Hassan", Profession: "Plumber", Rating: 4.9, Distance: "0.8 km", ReviewCount: 127, HourlyRate: money.Dinars(180), Available: true},
+		{ID: "w-002", Name: "Ahmed El-Sayed", Profession: "Electrician", Rating: 4.8, Distance: "1.2 km", ReviewCount: 98, HourlyRate: money.Dinars(200), Available: true},
+		{ID: "w-003", Name: "Hossam Abid", Profession: "Painter", Rating: 4.5, Distance: "2.1 km", ReviewCount: 55, HourlyRate: money.Dinars(150), Available: false},
+		{ID: "w-004", Name: "Youssef Mansour", Profession: "AC Technician", Rating: 4.7, Distance: "1.5 km", ReviewCount: 89, HourlyRate: money.Dinars(190), Available: true},
+		{ID: "w-005", Name: "Karim Saidi", Profession: "Cleaner", Rating: 4.6, Distance: "0.5 km", ReviewCount: 112, HourlyRate: money.Dinars(120), Available: true},
+		// Next batch
+		{ID: "w-006", Name: "Ali Ben Salem", Profession: "Plumber", Rating: 4.8, Distance: "3.2 km", ReviewCount: 145, HourlyRate: money.Dinars(170), Available: true},
+		{ID: "w-007", Name: "Sofiane Gharbi", Profession: "Electrician", Rating: 4.9, Distance: "2.8 km", ReviewCount: 203, HourlyRate: money.Dinars(210), Available: false},
+		{ID: "w-008", Name: "Mehdi Trabelsi", Profession: "Carpenter", Rating: 4.7, Distance: "1.9 km", ReviewCount: 78, HourlyRate: money.Dinars(165), Available: true},
+		{ID: "w-009", Name: "Nabil Chebbi", Profession: "Locksmith", Rating: 4.6, Distance: "2.5 km", ReviewCount: 92, HourlyRate: money.Dinars(140), Available: true},
+		{ID: "w-010", Name: "Rami Bouazizi", Profession: "Painter", Rating: 4.5, Distance: "3.0 km", ReviewCount: 67, HourlyRate: money.Dinars(155), Available: true},
+		// Third batch
+		{ID: "w-011", Name: "Farid Jelassi", Profession: "AC Technician", Rating: 4.8, Distance: "1.8 km", ReviewCount: 134, HourlyRate: money.Dinars(195), Available: true},
+		{ID: "w-012", Name: "Tarek Maatoug", Profession: "Plumber", Rating: 4.7, Distance: "2.2 km", ReviewCount: 101, HourlyRate: money.Dinars(175), Available: false},
+		{ID: "w-013", Name: "Walid Hamdi", Profession: "Electrician", Rating: 4.9, Distance: "0.9 km", ReviewCount: 187, HourlyRate: money.Dinars(205), Available: true},
+		{ID: "w-014", Name: "Sami Ayari", Profession: "Cleaner", Rating: 4.6, Distance: "1.7 km", ReviewCount: 88, HourlyRate: money.Dinars(125), Available: true},
+		{ID: "w-015", Name: "Bassem Jribi", Profession: "Carpenter", Rating: 4.5, Distance: "3.5 km", ReviewCount: 72, HourlyRate: money.Dinars(160), Available: true},
+	}
+
+	// Profile details not yet provided per worker
+	for i := range workers {
+		workers[i].CompletedJobs = 340
+		workers[i].YearsExperience = 12
+		workers[i].About = "Professional installation and maintenance of electrical wiring, fixtures, and appliances."
+		workers[i].Skills = []string{"Plumbing", "Repair", "Installation"}
+	}
+	return workers
+}
+
+// createCategoryButton creates a clickable category button with icon image.
+// name is the English category name as stored by the API, onTapped receives it.
+func createCategoryButton(state AppState, iconKey assets.Key, name string, onTapped func(name string)) fyne.CanvasObject {
 	// Create image from resource
 	iconImage := canvas.NewImageFromResource(state.GetImage(iconKey))
 	iconImage.FillMode = canvas.ImageFillContain
 	iconImage.SetMinSize(fyne.NewSize(32, 32))
 
-	nameLabel := widget.NewLabel(name)
+	nameLabel := newLabel(i18n.T(name))
 	nameLabel.Alignment = fyne.TextAlignCenter
 	nameLabel.Wrapping = fyne.TextWrapWord
 
@@ -467,8 +761,7 @@ func createCategoryButton(state AppState, iconKey, name string) fyne.CanvasObjec
 	// Create a button that wraps the content
 	btn := widget.NewButton("", func() {
 		fmt.Println("Category clicked:", name)
-		fmt.Println("Filtering workers by category:", name)
-		// TODO: Filter workers by selected category
+		onTapped(name)
 	})
 
 	// Stack the content on top of the button
@@ -0,0 +1,104 @@
+package ui
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/test"
+	"fyne.io/fyne/v2/widget"
+
+	"skillDar/pkg/assets"
+)
+
+// profileTestState is the part of the app state the profile tab uses
+type profileTestState struct {
+	AppState
+	profile *ProfileStore
+	errors  atomic.Int32
+}
+
+func (s *profileTestState) Profile() *ProfileStore                       { return s.profile }
+func (s *profileTestState) GetUserRole() string                          { return "client" }
+func (s *profileTestState) IsDarkTheme() bool                            { return false }
+func (s *profileTestState) GetImage(assets.Key) fyne.Resource            { return nil }
+func (s *profileTestState) ShowConnectionError(ConnectionStatus, string) { s.errors.Add(1) }
+
+// shownTexts returns the texts of the visible labels and buttons in order
+func shownTexts(o fyne.CanvasObject) []string {
+	if !o.Visible() {
+		return nil
+	}
+	switch o := o.(type) {
+	case *Screen:
+		return shownTexts(o.Content)
+	case *fyne.Container:
+		var texts []string
+		for _, child := range o.Objects {
+			texts = append(texts, shownTexts(child)...)
+		}
+		return texts
+	case *widget.Label:
+		if o.Text != "" {
+			return []string{o.Text}
+		}
+	case *widget.Button:
+		return []string{o.Text}
+	}
+	return nil
+}
+
+func TestProfileTabLoad(t *testing.T) {
+	test.NewTempApp(t)
+	var requests atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if requests.Add(1) == 1 {
+			http.Error(w, "unavailable", http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte(`{"id": "u1", "name": "Amira", "email": "amira@example.tn", "phone": "+21620123456"}`))
+	}))
+	defer srv.Close()
+
+	state := &profileTestState{profile: NewProfileStore(&APIConfig{BaseURL: srv.URL, Timeout: time.Second})}
+	tab := createProfileContent(state)
+	defer tab.(*Screen).OnDestroy()
+	texts := func() []string { return shownTexts(tab)[1:5] } // After the title
+	if got, want := texts(), []string{"Loading profile...", "Edit Profile", "♥ Saved Workers", "📍 Saved Addresses"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("before loading %q, want %q", got, want)
+	}
+
+	// A failed load offers to try again
+	done := make(chan error)
+	state.profile.Load(func(err error) { done <- err })
+	if err := <-done; err == nil {
+		t.Fatal("first load succeeded, want an error")
+	}
+	if got, want := texts(), []string{"Could not load your profile", "Retry", "Edit Profile", "♥ Saved Workers"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("after a failed load %q, want %q", got, want)
+	}
+
+	// Retrying shows the loaded profile
+	for _, o := range tab.(*Screen).Content.(*fyne.Container).Objects {
+		if button, ok := o.(*widget.Button); ok && button.Text == "Retry" {
+			test.Tap(button)
+		}
+	}
+	want := []string{"Amira", "amira@example.tn", "+21620123456", "Edit Profile"}
+	waitFor(t, func() bool { return reflect.DeepEqual(texts(), want) })
+	if n := state.errors.Load(); n != 0 {
+		t.Errorf("%d connection errors shown, want 0 after a successful retry", n)
+	}
+
+	// Edits saved elsewhere show up
+	profile := state.profile.Profile()
+	profile.Name = "Amira Ben Salah"
+	state.profile.Set(profile)
+	if got := texts()[0]; got != "Amira Ben Salah" {
+		t.Errorf("after an edit the name is %q", got)
+	}
+}
@@ -0,0 +1,186 @@
+package ui
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/widget"
+
+	"skillDar/pkg/i18n"
+	"skillDar/pkg/router"
+)
+
+// ViewStateful is implemented by screens that restore more than their
+// scroll position when navigated back to, e.g. the selected tab
+type ViewStateful interface {
+	ViewState() router.ViewState
+	RestoreViewState(view router.ViewState)
+}
+
+// ScreenLifecycle is implemented by screens that need to know when they
+// are on screen, e.g. to poll only while visible or reload when revisited.
+// Screens are built on first use and kept until OnDestroy.
+type ScreenLifecycle interface {
+	OnShow()    // The screen became the visible screen
+	OnHide()    // Another screen was shown on top or instead
+	OnDestroy() // The screen was dropped and will not be shown again
+}
+
+// LeaveGuard is implemented by screens that may keep the user from going
+// back, e.g. to warn about unsaved edits
+type LeaveGuard interface {
+	// ConfirmLeave returns true to leave right away. Otherwise it asks the
+	// user and calls leave if they still want to go.
+	ConfirmLeave(leave func()) bool
+}
+
+// Screen adds lifecycle callbacks to a screen's content.
+// Callbacks left nil are skipped.
+type Screen struct {
+	widget.BaseWidget
+
+	Content     fyne.CanvasObject
+	ShowFunc    func()
+	HideFunc    func()
+	DestroyFunc func()
+	LeaveFunc   func(leave func()) bool
+	showCount   int
+}
+
+// NewScreen wraps content, set the callbacks on the result
+func NewScreen(content fyne.CanvasObject) *Screen {
+	s := &Screen{Content: content}
+	s.ExtendBaseWidget(s)
+	return s
+}
+
+// Revisited reports whether the screen was shown before the current visit
+func (s *Screen) Revisited() bool {
+	return s.showCount > 1
+}
+
+// OnShow implements ScreenLifecycle
+func (s *Screen) OnShow() {
+	s.showCount++
+	if s.ShowFunc != nil {
+		s.ShowFunc()
+	}
+}
+
+// OnHide implements ScreenLifecycle
+func (s *Screen) OnHide() {
+	if s.HideFunc != nil {
+		s.HideFunc()
+	}
+}
+
+// OnDestroy implements ScreenLifecycle
+func (s *Screen) OnDestroy() {
+	if s.DestroyFunc != nil {
+		s.DestroyFunc()
+	}
+}
+
+// ConfirmLeave implements LeaveGuard
+func (s *Screen) ConfirmLeave(leave func()) bool {
+	if s.LeaveFunc == nil {
+		return true
+	}
+	return s.LeaveFunc(leave)
+}
+
+func (s *Screen) CreateRenderer() fyne.WidgetRenderer {
+	return widget.NewSimpleRenderer(s.Content)
+}
+
+// FetchWorker returns a worker's public profile
+func FetchWorker(config *APIConfig, workerID string) (WorkerProfile, error) {
+	var worker WorkerProfile
+	err := APIRequestJSON(config, http.MethodGet, "/workers/"+url.PathEscape(workerID), nil, &worker)
+	return worker, err
+}
+
+// LoadWorkerScreen builds a screen for a route that only carries a worker ID.
+// known is used right away when the profile was already on screen, otherwise
+// the profile is loaded first. When loading fails the error is shown with a
+// button to try again. The lifecycle calls reach the built screen once it
+// is loaded.
+func LoadWorkerScreen(state AppState, workerID string, known *WorkerProfile, build func(WorkerProfile) fyne.CanvasObject) fyne.CanvasObject {
+	if known != nil {
+		return build(*known)
+	}
+
+	loading := newLabel(i18n.T("Loading..."))
+	loading.Alignment = fyne.TextAlignCenter
+	loading.Wrapping = fyne.TextWrapWord
+	retryBtn := widget.NewButton(i18n.T("Retry"), nil)
+	retryBtn.Hide()
+	content := container.NewStack(container.NewCenter(container.NewVBox(loading, retryBtn)))
+
+	screen := NewScreen(content)
+	var built fyne.CanvasObject
+	shown, destroyed := false, false
+	screen.ShowFunc = func() {
+		shown = true
+		if s, ok := built.(ScreenLifecycle); ok {
+			s.OnShow()
+		}
+	}
+	screen.HideFunc = func() {
+		shown = false
+		if s, ok := built.(ScreenLifecycle); ok {
+			s.OnHide()
+		}
+	}
+	screen.DestroyFunc = func() {
+		destroyed = true
+		if s, ok := built.(ScreenLifecycle); ok {
+			s.OnDestroy()
+		}
+	}
+	screen.LeaveFunc = func(leave func()) bool {
+		if g, ok := built.(LeaveGuard); ok {
+			return g.ConfirmLeave(leave)
+		}
+		return true
+	}
+
+	var load func()
+	load = func() {
+		loading.SetText(i18n.T("Loading..."))
+		retryBtn.Hide()
+		go func() {
+			worker, err := FetchWorker(DefaultAPIConfig(), workerID)
+			fyne.Do(func() {
+				if err != nil {
+					var apiErr *APIError
+					if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound {
+						loading.SetText(i18n.T("This worker could not be found"))
+						return
+					}
+					status, message := StatusForError(err)
+					loading.SetText(message)
+					retryBtn.Show()
+					state.ShowConnectionError(status, message)
+					return
+				}
+				if destroyed {
+					return
+				}
+				built = build(worker)
+				content.Objects = []fyne.CanvasObject{built}
+				content.Refresh()
+				if s, ok := built.(ScreenLifecycle); ok && shown {
+					s.OnShow()
+				}
+			})
+		}()
+	}
+	retryBtn.OnTapped = load
+	load()
+
+	return screen
+}
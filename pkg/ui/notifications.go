@@ -0,0 +1,275 @@
+package ui
+
+import (
+	"sync"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+
+	skilltheme "skillDar/pkg/theme"
+)
+
+// Severity tells how important a notification is, it sets the color
+// and how long the notification stays
+type Severity int
+
+const (
+	SeverityInfo Severity = iota
+	SeveritySuccess
+	SeverityWarning
+	SeverityError
+)
+
+// NoAutoDismiss keeps a notification until the user or the app dismisses it
+const NoAutoDismiss time.Duration = -1
+
+// maxVisibleNotifications is how many notifications are stacked at once,
+// later ones wait in the queue
+const maxVisibleNotifications = 3
+
+// Notification is a message shown at the top of the window
+type Notification struct {
+	Severity Severity
+	Message  string
+	Icon     string               // Shown before the message, the severity's icon when empty
+	Key      string               // A notification with the same key is replaced, e.g. the connection status
+	Category NotificationCategory // Not shown when the user turned the category off, always shown when empty
+	Duration time.Duration        // How long it stays, the severity's default when 0, see NoAutoDismiss
+	Action   *NotificationAction  // Optional button, e.g. "Retry"
+}
+
+// NotificationAction is a button on a notification
+type NotificationAction struct {
+	Label    string
+	OnTapped func() // Run on the UI thread after the notification is dismissed
+}
+
+// NotificationID identifies a notification to dismiss it, 0 for none
+type NotificationID int
+
+// defaultDuration is how long a notification stays when it sets no Duration
+func (s Severity) defaultDuration() time.Duration {
+	switch s {
+	case SeveritySuccess:
+		return 3 * time.Second
+	case SeverityWarning:
+		return 6 * time.Second
+	case SeverityError:
+		return 8 * time.Second
+	default:
+		return 4 * time.Second
+	}
+}
+
+// colors returns the theme colors of the background and the text
+func (s Severity) colors() (background, text fyne.ThemeColorName) {
+	switch s {
+	case SeveritySuccess:
+		return theme.ColorNameSuccess, theme.ColorNameForegroundOnSuccess
+	case SeverityWarning:
+		return theme.ColorNameWarning, theme.ColorNameForegroundOnWarning
+	case SeverityError:
+		return theme.ColorNameError, theme.ColorNameForegroundOnError
+	default:
+		return theme.ColorNamePrimary, theme.ColorNameForegroundOnPrimary
+	}
+}
+
+func (s Severity) icon() string {
+	switch s {
+	case SeveritySuccess:
+		return "✓"
+	case SeverityWarning, SeverityError:
+		return "⚠️"
+	default:
+		return "ℹ️"
+	}
+}
+
+// shownNotification is a notification in the stack or the queue
+type shownNotification struct {
+	id    NotificationID
+	n     Notification
+	timer *time.Timer
+	gen   int // Counts timer restarts, so a replaced timer firing late is ignored
+}
+
+// NotificationManager stacks notifications at the top of the window and
+// queues the ones that do not fit. Notifications are dismissed by the user,
+// by the app or when their time is up. It is safe to use from any goroutine,
+// the window is updated on the UI thread.
+type NotificationManager struct {
+	mu        sync.Mutex
+	settings  *SettingsStore // Notification categories the user turned off, nil shows all
+	lastID    NotificationID
+	visible   []*shownNotification
+	queued    []*shownNotification
+	container *fyne.Container
+}
+
+// NewNotificationManager creates a notification manager following the
+// user's notification settings. settings may be nil.
+func NewNotificationManager(settings *SettingsStore) *NotificationManager {
+	return &NotificationManager{settings: settings, container: container.NewVBox()}
+}
+
+// Container returns the notification area to be added to the window
+func (m *NotificationManager) Container() *fyne.Container {
+	return m.container
+}
+
+// Show shows a notification, or queues it while the stack is full.
+// It returns the ID to dismiss it with, 0 when its category is turned off.
+func (m *NotificationManager) Show(n Notification) NotificationID {
+	if n.Category != "" && m.settings != nil && !m.settings.NotificationEnabled(n.Category) {
+		return 0
+	}
+
+	m.mu.Lock()
+	defer m.refresh()
+	defer m.mu.Unlock()
+
+	if n.Key != "" {
+		for _, s := range m.visible {
+			if s.n.Key == n.Key {
+				s.n = n
+				m.startTimer(s)
+				return s.id
+			}
+		}
+		for _, s := range m.queued {
+			if s.n.Key == n.Key {
+				s.n = n
+				return s.id
+			}
+		}
+	}
+
+	m.lastID++
+	s := &shownNotification{id: m.lastID, n: n}
+	if len(m.visible) < maxVisibleNotifications {
+		m.visible = append(m.visible, s)
+		m.startTimer(s)
+	} else {
+		m.queued = append(m.queued, s)
+	}
+	return s.id
+}
+
+// Dismiss removes a shown or queued notification
+func (m *NotificationManager) Dismiss(id NotificationID) {
+	m.dismiss(func(s *shownNotification) bool { return s.id == id })
+}
+
+// DismissKey removes the notification shown or queued with the key
+func (m *NotificationManager) DismissKey(key string) {
+	m.dismiss(func(s *shownNotification) bool { return s.n.Key == key })
+}
+
+// DismissAll removes every notification
+func (m *NotificationManager) DismissAll() {
+	m.dismiss(func(*shownNotification) bool { return true })
+}
+
+// dismiss removes the notifications matching remove and moves queued ones
+// up into the stack
+func (m *NotificationManager) dismiss(remove func(*shownNotification) bool) {
+	m.mu.Lock()
+	defer m.refresh()
+	defer m.mu.Unlock()
+
+	var visible []*shownNotification
+	for _, s := range m.visible {
+		if remove(s) {
+			if s.timer != nil {
+				s.timer.Stop()
+			}
+			continue
+		}
+		visible = append(visible, s)
+	}
+	var queued []*shownNotification
+	for _, s := range m.queued {
+		if !remove(s) {
+			queued = append(queued, s)
+		}
+	}
+	for len(visible) < maxVisibleNotifications && len(queued) > 0 {
+		s := queued[0]
+		queued = queued[1:]
+		visible = append(visible, s)
+		m.startTimer(s)
+	}
+	m.visible, m.queued = visible, queued
+}
+
+// startTimer (re)starts the auto-dismiss timer of a visible notification.
+// m.mu must be held.
+func (m *NotificationManager) startTimer(s *shownNotification) {
+	if s.timer != nil {
+		s.timer.Stop()
+		s.timer = nil
+	}
+	s.gen++
+	duration := s.n.Duration
+	if duration == 0 {
+		duration = s.n.Severity.defaultDuration()
+	}
+	if duration < 0 {
+		return
+	}
+	gen := s.gen
+	s.timer = time.AfterFunc(duration, func() {
+		m.dismiss(func(other *shownNotification) bool { return other == s && other.gen == gen })
+	})
+}
+
+// refresh shows the current stack, on the UI thread
+func (m *NotificationManager) refresh() {
+	fyne.Do(func() {
+		m.mu.Lock()
+		visible := append([]*shownNotification(nil), m.visible...)
+		m.mu.Unlock()
+
+		objects := make([]fyne.CanvasObject, len(visible))
+		for i, s := range visible {
+			objects[i] = m.createView(s.id, s.n)
+		}
+		m.container.Objects = objects
+		m.container.Refresh()
+	})
+}
+
+// createView draws one notification in the colors of its severity
+func (m *NotificationManager) createView(id NotificationID, n Notification) fyne.CanvasObject {
+	background, text := n.Severity.colors()
+	bg := skilltheme.NewThemedRectangle(background)
+
+	iconText := n.Icon
+	if iconText == "" {
+		iconText = n.Severity.icon()
+	}
+	icon := skilltheme.NewThemedText(iconText, text)
+	icon.SizeName = theme.SizeNameSubHeadingText
+
+	message := skilltheme.NewThemedText(n.Message, text)
+	message.Alignment = textAlignLeading()
+
+	closeBtn := widget.NewButton("✕", func() { m.Dismiss(id) })
+	buttons := newRow(closeBtn)
+	if n.Action != nil {
+		action := n.Action
+		actionBtn := widget.NewButton(action.Label, func() {
+			m.Dismiss(id)
+			if action.OnTapped != nil {
+				action.OnTapped()
+			}
+		})
+		buttons = newRow(actionBtn, closeBtn)
+	}
+
+	return container.NewStack(bg, newBorderRow(nil, nil, icon, buttons, message))
+}
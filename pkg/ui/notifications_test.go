@@ -0,0 +1,182 @@
+package ui
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"fyne.io/fyne/v2/test"
+)
+
+// shownMessages returns the messages in the stack and in the queue
+func shownMessages(m *NotificationManager) (visible, queued []string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, s := range m.visible {
+		visible = append(visible, s.n.Message)
+	}
+	for _, s := range m.queued {
+		queued = append(queued, s.n.Message)
+	}
+	return visible, queued
+}
+
+func checkShown(t *testing.T, m *NotificationManager, wantVisible, wantQueued []string) {
+	t.Helper()
+	visible, queued := shownMessages(m)
+	if !reflect.DeepEqual(visible, wantVisible) || !reflect.DeepEqual(queued, wantQueued) {
+		t.Errorf("shown %q queued %q, want %q queued %q", visible, queued, wantVisible, wantQueued)
+	}
+	if got := len(m.Container().Objects); got != len(wantVisible) {
+		t.Errorf("container holds %d notifications, want %d", got, len(wantVisible))
+	}
+}
+
+// waitFor polls until done returns true or a second passed
+func waitFor(t *testing.T, done func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(time.Second)
+	for !done() {
+		if time.Now().After(deadline) {
+			t.Fatal("timed out")
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
+
+func TestNotificationQueue(t *testing.T) {
+	test.NewTempApp(t)
+	m := NewNotificationManager(nil)
+
+	var ids []NotificationID
+	for _, message := range []string{"a", "b", "c", "d", "e"} {
+		ids = append(ids, m.Show(Notification{Message: message, Duration: NoAutoDismiss}))
+	}
+	for i := 1; i < len(ids); i++ {
+		if ids[i] <= ids[i-1] {
+			t.Fatalf("IDs %v are not increasing", ids)
+		}
+	}
+	checkShown(t, m, []string{"a", "b", "c"}, []string{"d", "e"})
+
+	// Dismissing a shown notification moves the first queued one up
+	m.Dismiss(ids[1])
+	checkShown(t, m, []string{"a", "c", "d"}, []string{"e"})
+
+	// A queued notification can be dismissed before it is shown
+	m.Dismiss(ids[4])
+	checkShown(t, m, []string{"a", "c", "d"}, nil)
+
+	// Unknown and already dismissed IDs are ignored
+	m.Dismiss(ids[1])
+	m.Dismiss(0)
+	checkShown(t, m, []string{"a", "c", "d"}, nil)
+
+	m.DismissAll()
+	checkShown(t, m, nil, nil)
+}
+
+func TestNotificationKey(t *testing.T) {
+	test.NewTempApp(t)
+	m := NewNotificationManager(nil)
+
+	first := m.Show(Notification{Message: "offline", Key: "connection", Duration: NoAutoDismiss})
+	m.Show(Notification{Message: "saved", Duration: NoAutoDismiss})
+	second := m.Show(Notification{Message: "server down", Key: "connection", Duration: NoAutoDismiss})
+	if second != first {
+		t.Errorf("replacing notification got ID %d, want %d", second, first)
+	}
+	checkShown(t, m, []string{"server down", "saved"}, nil)
+
+	// A queued notification with the key is replaced in the queue
+	m.Show(Notification{Message: "x", Duration: NoAutoDismiss})
+	m.Show(Notification{Message: "queued", Key: "sync", Duration: NoAutoDismiss})
+	m.Show(Notification{Message: "queued again", Key: "sync", Duration: NoAutoDismiss})
+	checkShown(t, m, []string{"server down", "saved", "x"}, []string{"queued again"})
+
+	m.DismissKey("connection")
+	checkShown(t, m, []string{"saved", "x", "queued again"}, nil)
+
+	m.DismissKey("unknown")
+	checkShown(t, m, []string{"saved", "x", "queued again"}, nil)
+}
+
+func TestNotificationAutoDismiss(t *testing.T) {
+	test.NewTempApp(t)
+	m := NewNotificationManager(nil)
+
+	m.Show(Notification{Message: "stays", Duration: NoAutoDismiss})
+	m.Show(Notification{Message: "goes", Duration: 10 * time.Millisecond})
+	m.Show(Notification{Message: "fills", Duration: NoAutoDismiss})
+	m.Show(Notification{Message: "waits", Duration: 150 * time.Millisecond})
+	checkShown(t, m, []string{"stays", "goes", "fills"}, []string{"waits"})
+
+	// The queued notification's time only starts once it is shown
+	waitFor(t, func() bool {
+		visible, _ := shownMessages(m)
+		return reflect.DeepEqual(visible, []string{"stays", "fills", "waits"})
+	})
+	waitFor(t, func() bool {
+		visible, _ := shownMessages(m)
+		return len(visible) == 2
+	})
+	checkShown(t, m, []string{"stays", "fills"}, nil)
+}
+
+func TestNotificationReplacedTimer(t *testing.T) {
+	test.NewTempApp(t)
+	m := NewNotificationManager(nil)
+
+	// The timer of the replaced notification must not dismiss its replacement
+	m.Show(Notification{Message: "slow", Key: "connection", Duration: 20 * time.Millisecond})
+	m.Show(Notification{Message: "offline", Key: "connection", Duration: NoAutoDismiss})
+	time.Sleep(60 * time.Millisecond)
+	checkShown(t, m, []string{"offline"}, nil)
+
+	// A replacement restarts the time
+	m.Show(Notification{Message: "a", Key: "k", Duration: 200 * time.Millisecond})
+	time.Sleep(120 * time.Millisecond)
+	m.Show(Notification{Message: "b", Key: "k", Duration: 200 * time.Millisecond})
+	time.Sleep(120 * time.Millisecond)
+	checkShown(t, m, []string{"offline", "b"}, nil)
+	waitFor(t, func() bool {
+		visible, _ := shownMessages(m)
+		return len(visible) == 1
+	})
+}
+
+func TestNotificationCategory(t *testing.T) {
+	app := test.NewTempApp(t)
+	settings := NewSettingsStore(app)
+	settings.SetNotificationEnabled(NotifyPromotions, false)
+	m := NewNotificationManager(settings)
+
+	if id := m.Show(Notification{Message: "offer", Category: NotifyPromotions}); id != 0 {
+		t.Errorf("turned off category got ID %d, want 0", id)
+	}
+	if id := m.Show(Notification{Message: "booked", Category: NotifyBookings, Duration: NoAutoDismiss}); id == 0 {
+		t.Error("enabled category got ID 0")
+	}
+	m.Show(Notification{Message: "offline", Duration: NoAutoDismiss})
+	checkShown(t, m, []string{"booked", "offline"}, nil)
+}
+
+func TestConnectionNotification(t *testing.T) {
+	tests := []struct {
+		status       ConnectionStatus
+		wantSeverity Severity
+		wantDuration time.Duration
+	}{
+		{StatusNoInternet, SeverityError, NoAutoDismiss},
+		{StatusServerDown, SeverityError, NoAutoDismiss},
+		{StatusSlowConnection, SeverityWarning, 0},
+		{StatusConnected, SeveritySuccess, 0},
+	}
+	for _, tt := range tests {
+		n := tt.status.Notification("message")
+		if n.Severity != tt.wantSeverity || n.Duration != tt.wantDuration || n.Key != ConnectionNotificationKey {
+			t.Errorf("status %d: severity %d duration %v key %q, want %d %v %q",
+				tt.status, n.Severity, n.Duration, n.Key, tt.wantSeverity, tt.wantDuration, ConnectionNotificationKey)
+		}
+	}
+}
@@ -0,0 +1,114 @@
+package ui
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"skillDar/pkg/billing"
+	"skillDar/pkg/i18n"
+	"skillDar/pkg/money"
+)
+
+// OrderStatus is the lifecycle state of an order
+type OrderStatus string
+
+const (
+	OrderPending   OrderStatus = "pending"   // Waiting for the worker to accept
+	OrderAccepted  OrderStatus = "accepted"  // Scheduled
+	OrderDeclined  OrderStatus = "declined"  // Refused by the worker
+	OrderCompleted OrderStatus = "completed" // Job done
+	OrderCancelled OrderStatus = "cancelled" // Cancelled by the client
+)
+
+// orderStatusLabels name the order states, in English
+var orderStatusLabels = map[OrderStatus]string{
+	OrderPending:   "Pending",
+	OrderAccepted:  "Accepted",
+	OrderDeclined:  "Declined",
+	OrderCompleted: "Completed",
+	OrderCancelled: "Cancelled",
+}
+
+// Label returns the status in the app language
+func (s OrderStatus) Label() string {
+	if label, ok := orderStatusLabels[s]; ok {
+		return i18n.T(label)
+	}
+	return string(s)
+}
+
+// Order represents a booking between a client and a worker
+type Order struct {
+	ID          string      `json:"id"`
+	ClientName  string      `json:"client_name"`
+	WorkerID    string      `json:"worker_id"`
+	WorkerName  string      `json:"worker_name"`
+	Category    string      `json:"category"`
+	Address     string      `json:"address"`
+	Notes       string      `json:"notes"`
+	ScheduledAt time.Time   `json:"scheduled_at"`
+	Hours       int         `json:"hours"`
+	Total       money.Money `json:"total"` // Amount paid by the client
+	Status      OrderStatus `json:"status"`
+
+	CompletedAt    time.Time    `json:"completed_at,omitempty"`
+	CommissionRate int          `json:"commission_bps,omitempty"` // Platform commission in basis points, 0 = default
+	PayoutStatus   PayoutStatus `json:"payout_status,omitempty"`
+
+	PaymentID     string         `json:"payment_id,omitempty"`
+	PaymentMethod billing.Method `json:"payment_method,omitempty"`
+}
+
+// FetchClientOrders returns the signed-in client's orders, most recent first
+func FetchClientOrders(config *APIConfig) ([]Order, error) {
+	var orders []Order
+	err := APIRequestJSON(config, http.MethodGet, "/orders", nil, &orders)
+	return orders, err
+}
+
+// FetchOrder returns one of the signed-in user's orders
+func FetchOrder(config *APIConfig, orderID string) (Order, error) {
+	var order Order
+	err := APIRequestJSON(config, http.MethodGet, "/orders/"+url.PathEscape(orderID), nil, &order)
+	return order, err
+}
+
+// CancelOrder cancels one of the signed-in client's orders
+func CancelOrder(config *APIConfig, orderID string) error {
+	path := fmt.Sprintf("/orders/%s/cancel", url.PathEscape(orderID))
+	return APIRequestJSON(config, http.MethodPost, path, nil, nil)
+}
+
+// FetchWorkerJobs returns the signed-in worker's jobs with the given status.
+// day is optional and restricts the result to jobs scheduled on that date.
+func FetchWorkerJobs(config *APIConfig, status OrderStatus, day time.Time) ([]Order, error) {
+	query := url.Values{}
+	if status != "" {
+		query.Set("status", string(status))
+	}
+	if !day.IsZero() {
+		query.Set("date", day.Format("2006-01-02"))
+	}
+
+	var orders []Order
+	err := APIRequestJSON(config, http.MethodGet, "/worker/jobs?"+query.Encode(), nil, &orders)
+	return orders, err
+}
+
+// RespondToJob accepts or declines an incoming job request
+func RespondToJob(config *APIConfig, orderID string, accept bool) error {
+	action := "decline"
+	if accept {
+		action = "accept"
+	}
+	path := fmt.Sprintf("/worker/jobs/%s/%s", url.PathEscape(orderID), action)
+	return APIRequestJSON(config, http.MethodPost, path, nil, nil)
+}
+
+// SetWorkerAvailability updates whether the signed-in worker accepts new jobs
+func SetWorkerAvailability(config *APIConfig, available bool) error {
+	body := map[string]bool{"available": available}
+	return APIRequestJSON(config, http.MethodPut, "/worker/availability", body, nil)
+}
@@ -0,0 +1,117 @@
+package ui
+
+import (
+	"net/url"
+
+	"fyne.io/fyne/v2"
+
+	"skillDar/pkg/billing"
+	"skillDar/pkg/i18n"
+)
+
+// paymentReturnURL is where the hosted checkout page sends the client back to the app
+const paymentReturnURL = "skilldar://payment/return"
+
+// apiBackend lets the billing providers use the app's API configuration
+type apiBackend struct {
+	config *APIConfig
+}
+
+func (b apiBackend) Do(method, path string, body, out any) error {
+	return APIRequestJSON(b.config, method, path, body, out)
+}
+
+// PaymentProviders returns the payment providers offered in booking
+func PaymentProviders(config *APIConfig) billing.Providers {
+	backend := apiBackend{config: config}
+	return billing.NewProviders(
+		billing.NewCashProvider(backend),
+		billing.NewCardProvider(backend, paymentReturnURL),
+		billing.NewWalletProvider(backend),
+	)
+}
+
+// paymentMethodOptions lists the payment methods in the order shown to clients.
+// The labels are in English and translated by paymentMethodLabel.
+var paymentMethodOptions = []struct {
+	method billing.Method
+	label  string
+}{
+	{billing.MethodCash, "💵 Cash on completion"},
+	{billing.MethodCard, "💳 Card"},
+	{billing.MethodWallet, "👛 Wallet"},
+}
+
+// paymentMethodLabel returns the label shown for a payment method
+func paymentMethodLabel(method billing.Method) string {
+	for _, option := range paymentMethodOptions {
+		if option.method == method {
+			return i18n.T(option.label)
+		}
+	}
+	return string(method)
+}
+
+// paymentMethodFromLabel is the inverse of paymentMethodLabel
+func paymentMethodFromLabel(label string) billing.Method {
+	for _, option := range paymentMethodOptions {
+		if i18n.T(option.label) == label {
+			return option.method
+		}
+	}
+	return ""
+}
+
+// openCheckoutURL opens the hosted checkout page in the system browser
+func openCheckoutURL(rawURL string) error {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return err
+	}
+	var openErr error
+	fyne.DoAndWait(func() {
+		openErr = fyne.CurrentApp().OpenURL(u)
+	})
+	return openErr
+}
+
+// paymentStatusText describes a payment state for the client
+func paymentStatusText(payment billing.Payment) string {
+	switch payment.Status {
+	case billing.StatusPending:
+		if payment.Method == billing.MethodCard {
+			return "💳 " + i18n.T("Complete the payment in your browser...")
+		}
+		return i18n.T("Processing payment...")
+	case billing.StatusAuthorized:
+		return "💵 " + i18n.T("Pay %s in cash when the job is done", payment.Amount)
+	case billing.StatusPaid:
+		return "✅ " + i18n.T("Paid %s", payment.Amount)
+	case billing.StatusFailed:
+		if payment.Reason == billing.ReasonInsufficientBalance {
+			return "⚠ " + i18n.T("Not enough balance in your wallet")
+		}
+		if payment.Reason != "" {
+			return "⚠ " + i18n.T("Payment failed: %s", payment.Reason)
+		}
+		return "⚠ " + i18n.T("Payment failed")
+	case billing.StatusCancelled:
+		return i18n.T("Payment cancelled")
+	case billing.StatusRefunded:
+		return "↩ " + i18n.T("Refunded %s", payment.Amount)
+	}
+	return string(payment.Status)
+}
+
+// formatReceipt renders a receipt for a dialog
+func formatReceipt(receipt billing.Receipt) string {
+	text := i18n.T("Receipt %s", receipt.Number) + "\n" +
+		i18n.T("Order %s", receipt.OrderID) + "\n" +
+		paymentMethodLabel(receipt.Method) + "\n" +
+		i18n.T("Amount: %s", receipt.Amount) + "\n" +
+		i18n.T("Issued: %s", i18n.FormatDate(receipt.IssuedAt, "02 Jan 2006 15:04"))
+	if receipt.Refunded.Amount > 0 {
+		text += "\n" + i18n.T("Refunded: %s", receipt.Refunded.String())
+	}
+	return text
+}
@@ -4,64 +4,76 @@ import (
 	"fmt"
 
 	"fyne.io/fyne/v2"
-	"fyne.io/fyne/v2/canvas"
 	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/layout"
 	"fyne.io/fyne/v2/theme"
 	"fyne.io/fyne/v2/widget"
 
+	"skillDar/pkg/i18n"
+	"skillDar/pkg/money"
 	skilltheme "skillDar/pkg/theme"
 )
 
 // CreateProfileScreen builds the profile screen with stats and info cards
 func CreateProfileScreen(state AppState) fyne.CanvasObject {
 	// Header with back button and user name
-	backBtn := widget.NewButton("←", func() {
-		state.ShowScreen("main")
+	backBtn := widget.NewButtonWithIcon("", BackIcon(), func() {
+		state.GoBack()
 	})
-	userName := widget.NewLabel("Mohamed Hassan")
+	userName := newLabel("")
 	userName.TextStyle = fyne.TextStyle{Bold: true}
 
-	header := container.NewBorder(
+	header := newBorderRow(
 		nil, nil,
 		backBtn,
 		nil,
 		userName,
 	)
 
-	// Profile picture (circular placeholder)
-	profilePic := canvas.NewCircle(theme.Color(theme.ColorNamePrimary))
-	profilePicContainer := container.NewPadded(profilePic)
-	profilePicContainer.Resize(fyne.NewSize(80, 80))
+	// Profile picture
+	subs := &Subscriptions{}
+	profilePicContainer := container.NewCenter(newUserAvatar(state, 80, subs))
 
-	// User name and verification badge
-	userNameLabel := widget.NewLabel("Mohamed Hassan ✓")
+	// User name and verification badge, the name follows profile edits
+	userNameLabel := newLabel("")
 	userNameLabel.Alignment = fyne.TextAlignCenter
 	userNameLabel.TextStyle = fyne.TextStyle{Bold: true}
+	verified := false
+	showName := func() {
+		name := state.Profile().Profile().Name
+		userName.SetText(name)
+		if verified {
+			name += " ✓"
+		}
+		userNameLabel.SetText(name)
+	}
+	showName()
+	subs.Add(state.Profile().OnChanged(showName))
 
-	userType := widget.NewLabel("Plumber")
+	userType := newLabel("Plumber")
 	userType.Alignment = fyne.TextAlignCenter
 
 	// Stats row (experience, rating, etc.)
-	statsLabel := widget.NewLabel("⭐ 4.9 (127)  🔥 0.9 km")
+	statsLabel := newLabel("")
 	statsLabel.Alignment = fyne.TextAlignCenter
 
 	// Stats cards
-	stat1 := createStatCard("📦", "340", "Certificates")
-	stat2 := createStatCard("🏆", "12", "Years Experience")
-	stat3 := createStatCard("⭐", "4.9", "Rating")
+	// Certificates only appear once the backend has approved some
+	stat2 := createStatCard("🏆", "12", i18n.T("Years Experience"))
+	stat3 := createStatCard("⭐", "4.9", i18n.T("Rating"))
 
-	statsRow := container.NewGridWithColumns(3, stat1, stat2, stat3)
+	statsRow := container.NewGridWithColumns(2, stat2, stat3)
 
 	// Action buttons - remove importance to use default text color
-	callBtn := widget.NewButton("📞\nCall", func() {
+	callBtn := widget.NewButton("📞\n"+i18n.T("Call"), func() {
 		// TODO: Implement action
 	})
 
-	chatBtn := widget.NewButton("💬\nChat", func() {
+	chatBtn := widget.NewButton("💬\n"+i18n.T("Chat"), func() {
 		// TODO: Implement action
 	})
 
-	hireBtn := widget.NewButton("💼\nHire", func() {
+	hireBtn := widget.NewButton("💼\n"+i18n.T("Hire"), func() {
 		// TODO: Implement action
 	})
 
@@ -72,28 +84,62 @@ func CreateProfileScreen(state AppState) fyne.CanvasObject {
 	actionsRow := container.NewStack(navBarBg, buttonsGrid)
 
 	// Price card with background
-	priceTitle := widget.NewLabel("Per Hour")
+	priceTitle := newLabel(i18n.T("Per Hour"))
 	priceTitle.Alignment = fyne.TextAlignCenter
 
-	priceAmount := canvas.NewText("$80", theme.Color(skilltheme.ColorNameHighlight))
+	priceAmount := skilltheme.NewThemedText("-", theme.ColorNameForeground)
 	priceAmount.Alignment = fyne.TextAlignCenter
-	priceAmount.TextSize = 24
+	priceAmount.SizeName = theme.SizeNameHeadingText
 	priceAmount.TextStyle = fyne.TextStyle{Bold: true}
 
-	priceNote := widget.NewLabel("(Minimum 2 hours)")
+	priceNote := newLabel(i18n.N("(Minimum %d hour)", "(Minimum %d hours)", 2, 2))
 	priceNote.Alignment = fyne.TextAlignCenter
 
 	priceContent := container.NewVBox(priceTitle, priceAmount, priceNote)
-	priceBackground := canvas.NewRectangle(theme.Color(skilltheme.ColorNameHighlight))
+	priceBackground := skilltheme.NewThemedRectangle(skilltheme.ColorNameHighlight)
+	priceBackground.RadiusName = skilltheme.SizeNameRadiusCard
 	priceCard := container.NewStack(priceBackground, container.NewPadded(priceContent))
 
+	go func() {
+		profile, err := FetchMyWorkerProfile(DefaultAPIConfig())
+		if err != nil {
+			return
+		}
+		fyne.Do(func() {
+			verified = profile.Verified
+			showName()
+			userType.SetText(profile.Profession)
+			statsLabel.SetText(ratingSummary(profile, state.Settings().DistanceUnit()))
+
+			stats := []fyne.CanvasObject{
+				createStatCard("🏆", i18n.FormatNumber(int64(profile.YearsExperience)), i18n.T("Years Experience")),
+				createStatCard("⭐", i18n.FormatDecimal(float64(profile.Rating), 1), i18n.T("Rating")),
+			}
+			if profile.CertificateCount > 0 {
+				stats = append([]fyne.CanvasObject{
+					createStatCard("📦", i18n.FormatNumber(int64(profile.CertificateCount)), i18n.T("Certificates")),
+				}, stats...)
+			}
+			if profile.HourlyRate.Amount > 0 {
+				priceAmount.SetText(profile.HourlyRate.Compact(money.Locale()))
+			}
+			if profile.MinimumHours > 0 {
+				priceNote.SetText(i18n.N("(Minimum %d hour)", "(Minimum %d hours)", profile.MinimumHours, profile.MinimumHours))
+			}
+
+			statsRow.Layout = layout.NewGridLayoutWithColumns(len(stats))
+			statsRow.Objects = stats
+			statsRow.Refresh()
+		})
+	}()
+
 	// About section
-	aboutContent := widget.NewLabel("Professional plumber with 12 years of experience in all plumbing work...")
+	aboutContent := newLabel("Professional plumber with 12 years of experience in all plumbing work...")
 	aboutContent.Wrapping = fyne.TextWrapWord
 
-	skillContent := widget.NewLabel("• Pipe Installation\n• Leak Repairs\n• Drain Cleaning\n• Water Heater Services")
+	skillContent := newLabel("• Pipe Installation\n• Leak Repairs\n• Drain Cleaning\n• Water Heater Services")
 
-	reviewsContent := widget.NewLabel("⭐⭐⭐⭐⭐\n\"Excellent service! Highly recommend.\"\n\n⭐⭐⭐⭐⭐\n\"Very professional and timely.\"")
+	reviewsContent := newLabel("⭐⭐⭐⭐⭐\n\"Excellent service! Highly recommend.\"\n\n⭐⭐⭐⭐⭐\n\"Very professional and timely.\"")
 
 	tabContentContainer := container.NewStack()
 	switchTab := func(tabIndex int) {
@@ -114,15 +160,15 @@ func CreateProfileScreen(state AppState) fyne.CanvasObject {
 	}
 
 	// Update styling
-	tab1Btn := widget.NewButton("About", func() {
+	tab1Btn := widget.NewButton(i18n.T("About"), func() {
 		fmt.Println("about tab")
 		switchTab(0)
 	})
-	tab2Btn := widget.NewButton("Skills", func() {
+	tab2Btn := widget.NewButton(i18n.T("Skills"), func() {
 		fmt.Println("skill tab")
 		switchTab(1)
 	})
-	tab3Btn := widget.NewButton("Reviews", func() {
+	tab3Btn := widget.NewButton(i18n.T("Reviews"), func() {
 		fmt.Println("reviews tab")
 		switchTab(2)
 	})
@@ -132,7 +178,7 @@ func CreateProfileScreen(state AppState) fyne.CanvasObject {
 	switchTab(0) // Default to first tab
 
 	// Available button
-	availableBtn := widget.NewButton("Available Now for Booking", func() {
+	availableBtn := widget.NewButton(i18n.T("Available Now for Booking"), func() {
 		// TODO: Implement booking
 	})
 	availableBtn.Importance = widget.SuccessImportance
@@ -154,21 +200,22 @@ func CreateProfileScreen(state AppState) fyne.CanvasObject {
 		availableBtn,
 	)
 
-	scroll := container.NewVScroll(content)
-	return scroll
+	screen := NewScreen(container.NewVScroll(content))
+	screen.DestroyFunc = subs.Release
+	return screen
 }
 
 // createStatCard creates a card with icon, number, and label
 func createStatCard(icon, number, label string) *fyne.Container {
-	iconLabel := widget.NewLabel(icon)
+	iconLabel := newLabel(icon)
 	iconLabel.Alignment = fyne.TextAlignCenter
 	iconLabel.TextStyle = fyne.TextStyle{Bold: true}
 
-	numLabel := widget.NewLabel(number)
+	numLabel := newLabel(number)
 	numLabel.Alignment = fyne.TextAlignCenter
 	numLabel.TextStyle = fyne.TextStyle{Bold: true}
 
-	textLabel := widget.NewLabel(label)
+	textLabel := newLabel(label)
 	textLabel.Alignment = fyne.TextAlignCenter
 
 	card := container.NewVBox(iconLabel, numLabel, textLabel)
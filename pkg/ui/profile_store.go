@@ -0,0 +1,211 @@
+package ui
+
+import (
+	"bytes"
+	"image"
+	"net/http"
+	"sync"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/driver/desktop"
+	"fyne.io/fyne/v2/widget"
+
+	"skillDar/pkg/router"
+)
+
+// UserProfile is the signed-in user's own account profile
+type UserProfile struct {
+	ID        string `json:"id"`
+	Name      string `json:"name"`
+	Email     string `json:"email"`
+	Phone     string `json:"phone"`
+	Location  string `json:"location"`
+	Bio       string `json:"bio"`
+	AvatarURL string `json:"avatar_url,omitempty"`
+}
+
+// FetchMyProfile returns the signed-in user's profile
+func FetchMyProfile(config *APIConfig) (UserProfile, error) {
+	var profile UserProfile
+	err := APIRequestJSON(config, http.MethodGet, "/me", nil, &profile)
+	return profile, err
+}
+
+// UploadAvatar uploads a new profile picture and returns its URL.
+// onProgress is called from the upload goroutine and may be nil.
+func UploadAvatar(config *APIConfig, filename string, data []byte, onProgress func(sent, total int64)) (string, error) {
+	var resp struct {
+		AvatarURL string `json:"avatar_url"`
+	}
+	err := APIUploadFileWithProgress(config, "/me/avatar", "avatar", filename, bytes.NewReader(data), onProgress, &resp)
+	return resp.AvatarURL, err
+}
+
+// ProfileStore holds the signed-in user's profile so every screen showing
+// it, e.g. the avatar in the top bar, updates when it changes
+type ProfileStore struct {
+	mu        sync.Mutex
+	config    *APIConfig
+	profile   UserProfile
+	avatar    image.Image // Picture being uploaded, shown before its URL is known
+	loadErr   error       // Why the last Load failed, nil once a profile is loaded
+	listeners listenerList
+}
+
+// NewProfileStore creates an empty profile store, call Load after login
+func NewProfileStore(config *APIConfig) *ProfileStore {
+	return &ProfileStore{config: config}
+}
+
+// OnChanged registers a callback run on the UI thread whenever the profile
+// changes. Call the returned function to remove it.
+func (ps *ProfileStore) OnChanged(listener func()) (remove func()) {
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+	id := ps.listeners.add(listener)
+	return func() {
+		ps.mu.Lock()
+		defer ps.mu.Unlock()
+		ps.listeners.remove(id)
+	}
+}
+
+// Profile returns the current profile
+func (ps *ProfileStore) Profile() UserProfile {
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+	return ps.profile
+}
+
+// Avatar returns the avatar URL and, while a new picture is uploading or
+// right after, the picture itself
+func (ps *ProfileStore) Avatar() (string, image.Image) {
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+	return ps.profile.AvatarURL, ps.avatar
+}
+
+// LoadError returns why the last Load failed, so screens can offer to
+// try again. It is nil while loading and once a profile is loaded or set.
+func (ps *ProfileStore) LoadError() error {
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+	return ps.loadErr
+}
+
+// Load fetches the profile from the server, onDone may be nil. Listeners
+// are also notified when it fails.
+func (ps *ProfileStore) Load(onDone func(error)) {
+	ps.mu.Lock()
+	ps.loadErr = nil
+	ps.mu.Unlock()
+	go func() {
+		profile, err := FetchMyProfile(ps.config)
+		if err == nil {
+			ps.Set(profile)
+		} else {
+			ps.mu.Lock()
+			ps.loadErr = err
+			ps.mu.Unlock()
+			ps.notify()
+		}
+		if onDone != nil {
+			fyne.Do(func() { onDone(err) })
+		}
+	}()
+}
+
+// Set replaces the profile, e.g. after it was saved
+func (ps *ProfileStore) Set(profile UserProfile) {
+	ps.mu.Lock()
+	if profile.AvatarURL != ps.profile.AvatarURL {
+		ps.avatar = nil
+	}
+	ps.profile = profile
+	ps.loadErr = nil
+	ps.mu.Unlock()
+	ps.notify()
+}
+
+// SetAvatar shows a new picture everywhere right away. url is empty while
+// the picture uploads; pass the uploaded URL, or img nil to go back to
+// the previous picture if the upload failed.
+func (ps *ProfileStore) SetAvatar(img image.Image, url string) {
+	ps.mu.Lock()
+	ps.avatar = img
+	if url != "" {
+		ps.profile.AvatarURL = url
+	}
+	ps.mu.Unlock()
+	ps.notify()
+}
+
+func (ps *ProfileStore) notify() {
+	ps.mu.Lock()
+	listeners := ps.listeners.snapshot()
+	ps.mu.Unlock()
+
+	fyne.Do(func() {
+		for _, listener := range listeners {
+			listener()
+		}
+	})
+}
+
+// newUserAvatar shows the signed-in user's picture and follows its changes
+// until subs is released
+func newUserAvatar(state AppState, size float32, subs *Subscriptions) *RemoteImage {
+	profile := state.Profile()
+	url, img := profile.Avatar()
+	avatar := NewRemoteImage(url, fyne.NewSize(size, size), true)
+	update := func() {
+		url, img := profile.Avatar()
+		if img != nil {
+			avatar.SetImage(img)
+			return
+		}
+		avatar.SetURL(url)
+	}
+	if img != nil {
+		avatar.SetImage(img)
+	}
+	subs.Add(profile.OnChanged(update))
+	return avatar
+}
+
+// workerAvatar shows a worker's picture, following picture changes until
+// subs is released when the worker is the signed-in user
+func workerAvatar(state AppState, worker WorkerProfile, size float32, subs *Subscriptions) *RemoteImage {
+	if worker.ID != "" && worker.ID == state.Profile().Profile().ID {
+		return newUserAvatar(state, size, subs)
+	}
+	return NewRemoteImage(worker.AvatarURL, fyne.NewSize(size, size), true)
+}
+
+// AvatarButton is the signed-in user's picture in the top bar, tapping it
+// opens their profile
+type AvatarButton struct {
+	widget.BaseWidget
+	avatar *RemoteImage
+	state  AppState
+}
+
+// NewAvatarButton creates the top bar avatar, it follows picture changes.
+// It is kept for the whole session, so its listener is never removed.
+func NewAvatarButton(state AppState) *AvatarButton {
+	b := &AvatarButton{avatar: newUserAvatar(state, 32, &Subscriptions{}), state: state}
+	b.ExtendBaseWidget(b)
+	return b
+}
+
+func (b *AvatarButton) Tapped(*fyne.PointEvent) {
+	b.state.Navigate(router.To(router.Profile))
+}
+
+func (b *AvatarButton) Cursor() desktop.Cursor {
+	return desktop.PointerCursor
+}
+
+func (b *AvatarButton) CreateRenderer() fyne.WidgetRenderer {
+	return widget.NewSimpleRenderer(b.avatar)
+}
@@ -0,0 +1,79 @@
+package ui
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"skillDar/pkg/i18n"
+	"skillDar/pkg/money"
+)
+
+// Error codes returned by the API when a promo code cannot be used
+const (
+	promoNotFound   = "promo_not_found"
+	promoExpired    = "promo_expired"
+	promoUsageLimit = "promo_usage_limit"
+	promoMinAmount  = "promo_min_amount"
+)
+
+// PromoQuote describes a valid promo code and how its discount is computed
+type PromoQuote struct {
+	Code        string      `json:"code"`
+	Description string      `json:"description"`
+	PercentBps  int         `json:"percent_bps,omitempty"`  // Percentage off in basis points
+	Amount      money.Money `json:"amount,omitempty"`       // Fixed amount off
+	MaxDiscount money.Money `json:"max_discount,omitempty"` // Cap for percentage discounts, zero = none
+	ExpiresAt   time.Time   `json:"expires_at"`
+}
+
+// Discount returns the amount taken off a subtotal, never more than the subtotal
+func (q PromoQuote) Discount(subtotal money.Money) money.Money {
+	discount := q.Amount
+	if q.PercentBps > 0 {
+		discount = subtotal.Percent(q.PercentBps)
+		if !q.MaxDiscount.IsZero() && discount.Cmp(q.MaxDiscount) > 0 {
+			discount = q.MaxDiscount
+		}
+	}
+	if discount.Cmp(subtotal) > 0 {
+		return subtotal
+	}
+	return discount
+}
+
+// ValidatePromoCode checks a promo code for a booking with a worker
+func ValidatePromoCode(config *APIConfig, code, workerID string, subtotal money.Money) (PromoQuote, error) {
+	body := map[string]any{
+		"code":      strings.ToUpper(strings.TrimSpace(code)),
+		"worker_id": workerID,
+		"subtotal":  subtotal,
+	}
+	var quote PromoQuote
+	err := APIRequestJSON(config, http.MethodPost, "/promos/validate", body, &quote)
+	return quote, err
+}
+
+// promoErrorMessage explains why a promo code was refused.
+// ok is false when err is not a promo error, e.g. a network failure.
+func promoErrorMessage(err error) (string, bool) {
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		return "", false
+	}
+	switch apiErr.Code {
+	case promoNotFound:
+		return i18n.T("This promo code does not exist"), true
+	case promoExpired:
+		return i18n.T("This promo code has expired"), true
+	case promoUsageLimit:
+		return i18n.T("This promo code has already been used the maximum number of times"), true
+	case promoMinAmount:
+		if apiErr.Message != "" {
+			return apiErr.Message, true
+		}
+		return i18n.T("The booking is below the minimum amount for this code"), true
+	}
+	return "", false
+}
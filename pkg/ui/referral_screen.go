@@ -0,0 +1,181 @@
+package ui
+
+import (
+	"net/http"
+	"net/url"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/widget"
+
+	"skillDar/pkg/i18n"
+	"skillDar/pkg/money"
+)
+
+// ReferralStatus tracks an invited friend's progress towards the reward
+type ReferralStatus string
+
+const (
+	ReferralJoined   ReferralStatus = "joined"   // Signed up, no completed booking yet
+	ReferralCredited ReferralStatus = "credited" // First booking completed, reward in the wallet
+)
+
+// ReferralReward is a friend who signed up with the user's referral code
+type ReferralReward struct {
+	FriendName string         `json:"friend_name"`
+	Status     ReferralStatus `json:"status"`
+	Amount     money.Money    `json:"amount"`
+	JoinedAt   time.Time      `json:"joined_at"`
+	CreditedAt time.Time      `json:"credited_at,omitempty"`
+}
+
+// Referral is the signed-in user's referral code and the rewards earned with it
+type Referral struct {
+	Code         string           `json:"code"`
+	ShareURL     string           `json:"share_url"`
+	RewardAmount money.Money      `json:"reward_amount"` // Credited per friend, for both sides
+	Rewards      []ReferralReward `json:"rewards"`
+}
+
+// Credited returns the total of the rewards already credited to the wallet
+func (r Referral) Credited() money.Money {
+	var total money.Money
+	for _, reward := range r.Rewards {
+		if reward.Status == ReferralCredited {
+			total = total.Add(reward.Amount)
+		}
+	}
+	return total
+}
+
+// FetchReferral returns the signed-in user's referral code and rewards
+func FetchReferral(config *APIConfig) (Referral, error) {
+	var referral Referral
+	err := APIRequestJSON(config, http.MethodGet, "/referrals", nil, &referral)
+	return referral, err
+}
+
+// referralMessage is the invitation text shared with friends
+func referralMessage(r Referral) string {
+	return i18n.T("Book trusted home services on SkillDar! Sign up with my code %s and we both get %s in our wallets: %s",
+		r.Code, r.RewardAmount, r.ShareURL)
+}
+
+// createReferralRow shows one invited friend and the reward status
+func createReferralRow(reward ReferralReward) fyne.CanvasObject {
+	name := newLabel(reward.FriendName + "\n" + i18n.T("Joined %s", i18n.FormatDate(reward.JoinedAt, "02 Jan 2006")))
+
+	status := newLabel(i18n.T("Waiting for first booking"))
+	status.Importance = widget.WarningImportance
+	if reward.Status == ReferralCredited {
+		status.SetText("+" + reward.Amount.String())
+		status.Importance = widget.SuccessImportance
+	}
+
+	return newBorderRow(nil, nil, nil, status, name)
+}
+
+// CreateReferralScreen shows the referral code, share actions and earned rewards
+func CreateReferralScreen(state AppState) fyne.CanvasObject {
+	apiConfig := DefaultAPIConfig()
+
+	title := newLabel(i18n.T("Refer a Friend"))
+	title.Alignment = fyne.TextAlignCenter
+	title.TextStyle = fyne.TextStyle{Bold: true}
+
+	var referral Referral
+
+	intro := newLabel(i18n.T("Invite friends to SkillDar. When they complete their first booking, you both get credit in your wallet."))
+	intro.Wrapping = fyne.TextWrapWord
+
+	codeLabel := newLabel("-")
+	codeLabel.Alignment = fyne.TextAlignCenter
+	codeLabel.TextStyle = fyne.TextStyle{Bold: true, Monospace: true}
+	shareStatus := newLabel("")
+	shareStatus.Alignment = fyne.TextAlignCenter
+
+	copyBtn := widget.NewButton("📋 "+i18n.T("Copy Invitation"), func() {
+		fyne.CurrentApp().Clipboard().SetContent(referralMessage(referral))
+		shareStatus.SetText(i18n.T("Invitation copied, paste it anywhere to share"))
+	})
+	whatsAppBtn := widget.NewButton("💬 "+i18n.T("Share on WhatsApp"), func() {
+		u, err := url.Parse("https://wa.me/?text=" + url.QueryEscape(referralMessage(referral)))
+		if err == nil {
+			fyne.CurrentApp().OpenURL(u)
+		}
+	})
+	emailBtn := widget.NewButton("✉ "+i18n.T("Share by Email"), func() {
+		query := url.Values{}
+		query.Set("subject", i18n.T("Join me on SkillDar"))
+		query.Set("body", referralMessage(referral))
+		u, err := url.Parse("mailto:?" + query.Encode())
+		if err == nil {
+			fyne.CurrentApp().OpenURL(u)
+		}
+	})
+	shareButtons := []*widget.Button{copyBtn, whatsAppBtn, emailBtn}
+	for _, btn := range shareButtons {
+		btn.Disable()
+	}
+	copyBtn.Importance = widget.HighImportance
+
+	creditedCard := createStatCard("👛", "-", i18n.T("Credited"))
+	friendsCard := createStatCard("🤝", "-", i18n.T("Friends Joined"))
+	statsRow := container.NewGridWithColumns(2, creditedCard, friendsCard)
+
+	rewardsLabel := newLabel(i18n.T("Your Referrals"))
+	rewardsLabel.TextStyle = fyne.TextStyle{Bold: true}
+	rewardsBox := container.NewVBox(newLabel(i18n.T("Loading...")))
+
+	go func() {
+		result, err := FetchReferral(apiConfig)
+		fyne.Do(func() {
+			rewardsBox.Objects = nil
+			if err != nil {
+				state.ShowConnectionError(StatusForError(err))
+				rewardsBox.Add(newLabel(i18n.T("Could not load your referrals")))
+				rewardsBox.Refresh()
+				return
+			}
+			referral = result
+
+			codeLabel.SetText(referral.Code)
+			for _, btn := range shareButtons {
+				btn.Enable()
+			}
+			if !referral.RewardAmount.IsZero() {
+				intro.SetText(i18n.T("Invite friends to SkillDar. When they complete their first booking, you both get %s in your wallet.",
+					referral.RewardAmount))
+			}
+
+			statsRow.Objects = []fyne.CanvasObject{
+				createStatCard("👛", referral.Credited().String(), i18n.T("Credited")),
+				createStatCard("🤝", i18n.FormatNumber(int64(len(referral.Rewards))), i18n.T("Friends Joined")),
+			}
+			statsRow.Refresh()
+
+			if len(referral.Rewards) == 0 {
+				rewardsBox.Add(newLabel(i18n.T("No friends have joined yet")))
+			}
+			for _, reward := range referral.Rewards {
+				rewardsBox.Add(createReferralRow(reward))
+			}
+			rewardsBox.Refresh()
+		})
+	}()
+
+	content := container.NewVBox(
+		intro,
+		widget.NewCard(i18n.T("Your Code"), "", codeLabel),
+		copyBtn,
+		container.NewGridWithColumns(2, whatsAppBtn, emailBtn),
+		shareStatus,
+		widget.NewSeparator(),
+		statsRow,
+		rewardsLabel,
+		rewardsBox,
+	)
+
+	return container.NewBorder(title, nil, nil, nil, container.NewVScroll(container.NewPadded(content)))
+}
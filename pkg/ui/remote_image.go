@@ -0,0 +1,233 @@
+package ui
+
+import (
+	"context"
+	"image"
+	"net/http"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+
+	"skillDar/pkg/images"
+	skilltheme "skillDar/pkg/theme"
+)
+
+// Image cache limits
+const (
+	imageMemoryCacheSize = 32 << 20  // Decoded pixels kept in memory
+	imageDiskCacheSize   = 100 << 20 // Downloaded files kept in app storage
+)
+
+var (
+	imageLoaderOnce   sync.Once
+	sharedImageLoader *images.Loader
+)
+
+// imageLoader returns the loader shared by all remote images.
+// The disk cache lives in the app storage and is skipped if unavailable.
+func imageLoader() *images.Loader {
+	imageLoaderOnce.Do(func() {
+		sharedImageLoader = &images.Loader{
+			Client: &http.Client{Timeout: 30 * time.Second},
+			Memory: images.NewMemoryCache(imageMemoryCacheSize),
+		}
+		sharedImageLoader.CacheFirst.Store(fyne.CurrentApp().Preferences().Bool(prefSettingsDataSaver))
+		dir := filepath.Join(fyne.CurrentApp().Storage().RootURI().Path(), "image-cache")
+		if disk, err := images.NewDiskCache(dir, imageDiskCacheSize); err == nil {
+			sharedImageLoader.Disk = disk
+		} else {
+			fyne.LogError("Image disk cache disabled", err)
+		}
+	})
+	return sharedImageLoader
+}
+
+// RemoteImage shows a picture loaded from a URL, such as an avatar or a
+// portfolio photo. A placeholder is shown while loading and an error image
+// if loading fails. Loading starts when the image is first drawn, or is
+// driven by an ImageViewport for images in long lists.
+type RemoteImage struct {
+	widget.BaseWidget
+
+	url      string
+	circular bool
+	size     fyne.Size
+	managed  bool // Loading is started and cancelled by an ImageViewport
+
+	content *fyne.Container
+	cancel  context.CancelFunc
+	loaded  bool
+	custom  bool // Showing an image set with SetImage instead of the URL's
+}
+
+// NewRemoteImage creates an image of a fixed size, circular crops it to a circle
+func NewRemoteImage(url string, size fyne.Size, circular bool) *RemoteImage {
+	r := &RemoteImage{url: url, circular: circular, size: size}
+	r.content = container.NewStack(r.placeholder())
+	r.ExtendBaseWidget(r)
+	return r
+}
+
+// placeholder is shown until the image is loaded, and when there is no URL
+func (r *RemoteImage) placeholder() fyne.CanvasObject {
+	if r.circular {
+		return skilltheme.NewThemedCircle(skilltheme.ColorNameHighlight)
+	}
+	return skilltheme.NewThemedRectangle(theme.ColorNameInputBackground)
+}
+
+// SetURL shows another picture, e.g. after a new avatar was uploaded.
+// After SetImage it shows the URL's picture again, even the same URL.
+func (r *RemoteImage) SetURL(url string) {
+	if url == r.url && !r.custom {
+		return
+	}
+	r.Cancel()
+	r.url = url
+	r.loaded = false
+	r.custom = false
+	r.content.Objects = []fyne.CanvasObject{r.placeholder()}
+	r.content.Refresh()
+	if !r.managed {
+		r.Load()
+	}
+}
+
+// SetImage shows an image right away, e.g. the picture being uploaded
+func (r *RemoteImage) SetImage(img image.Image) {
+	r.Cancel()
+	r.loaded = true
+	r.custom = true
+	r.show(img)
+}
+
+// Load starts loading the picture, it does nothing if it is loaded or loading
+func (r *RemoteImage) Load() {
+	if r.url == "" || r.loaded || r.cancel != nil {
+		return
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	r.cancel = cancel
+	url := r.url
+
+	go func() {
+		img, err := imageLoader().Load(ctx, url)
+		if ctx.Err() != nil {
+			return // Cancelled, e.g. scrolled out of view
+		}
+		fyne.Do(func() {
+			if url != r.url {
+				return
+			}
+			r.cancel = nil
+			r.loaded = true
+			if err != nil {
+				fyne.LogError("Could not load image "+url, err)
+				broken := canvas.NewImageFromResource(theme.BrokenImageIcon())
+				broken.FillMode = canvas.ImageFillContain
+				r.content.Objects = []fyne.CanvasObject{r.placeholder(), broken}
+				r.content.Refresh()
+				return
+			}
+			r.show(img)
+		})
+	}()
+}
+
+// Cancel abandons a load in progress, Load starts it again
+func (r *RemoteImage) Cancel() {
+	if r.cancel != nil {
+		r.cancel()
+		r.cancel = nil
+	}
+}
+
+func (r *RemoteImage) show(img image.Image) {
+	if r.circular {
+		img = images.CropCircle(img)
+	}
+	picture := canvas.NewImageFromImage(img)
+	picture.FillMode = canvas.ImageFillContain
+	if !r.circular {
+		picture.FillMode = canvas.ImageFillCover
+	}
+	r.content.Objects = []fyne.CanvasObject{picture}
+	r.content.Refresh()
+}
+
+func (r *RemoteImage) MinSize() fyne.Size {
+	return r.size
+}
+
+func (r *RemoteImage) CreateRenderer() fyne.WidgetRenderer {
+	if !r.managed {
+		r.Load()
+	}
+	return widget.NewSimpleRenderer(r.content)
+}
+
+// ImageViewport loads the remote images of a scrolled list while they are
+// in view, and cancels the loads of images scrolled out of view before
+// they finished.
+type ImageViewport struct {
+	scroll *container.Scroll
+	images []*RemoteImage
+}
+
+// NewImageViewport watches a scroll container, keeping its OnScrolled callback
+func NewImageViewport(scroll *container.Scroll) *ImageViewport {
+	v := &ImageViewport{scroll: scroll}
+	previous := scroll.OnScrolled
+	scroll.OnScrolled = func(pos fyne.Position) {
+		if previous != nil {
+			previous(pos)
+		}
+		v.Update()
+	}
+	return v
+}
+
+// Add puts an image under the viewport's control.
+// Before the list is laid out, images are loaded right away.
+func (v *ImageViewport) Add(img *RemoteImage) {
+	img.managed = true
+	v.images = append(v.images, img)
+	if v.scroll.Size().IsZero() || v.isVisible(img) {
+		img.Load()
+	}
+}
+
+// Clear cancels the loads of all images and forgets them, e.g. when the
+// list is filled again
+func (v *ImageViewport) Clear() {
+	for _, img := range v.images {
+		img.Cancel()
+	}
+	v.images = nil
+}
+
+// Update loads the images in view and cancels the others
+func (v *ImageViewport) Update() {
+	for _, img := range v.images {
+		if v.isVisible(img) {
+			img.Load()
+		} else {
+			img.Cancel()
+		}
+	}
+}
+
+func (v *ImageViewport) isVisible(img *RemoteImage) bool {
+	driver := fyne.CurrentApp().Driver()
+	viewPos := driver.AbsolutePositionForObject(v.scroll)
+	imgPos := driver.AbsolutePositionForObject(img)
+	viewSize, imgSize := v.scroll.Size(), img.Size()
+	return imgPos.Y+imgSize.Height >= viewPos.Y && imgPos.Y <= viewPos.Y+viewSize.Height &&
+		imgPos.X+imgSize.Width >= viewPos.X && imgPos.X <= viewPos.X+viewSize.Width
+}
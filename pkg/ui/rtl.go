@@ -0,0 +1,79 @@
+package ui
+
+import (
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+
+	"skillDar/pkg/i18n"
+)
+
+// Fyne lays everything out left to right. Screens are built for the
+// current locale, so for Arabic these helpers mirror rows, the leading
+// edge of text and buttons, and the direction of arrows.
+
+// newLabel creates a label aligned to the reading edge
+func newLabel(text string) *widget.Label {
+	label := widget.NewLabel(text)
+	label.Alignment = textAlignLeading()
+	return label
+}
+
+// textAlignLeading is where text starts in the current locale
+func textAlignLeading() fyne.TextAlign {
+	if i18n.RightToLeft() {
+		return fyne.TextAlignTrailing
+	}
+	return fyne.TextAlignLeading
+}
+
+// textAlignTrailing is where text ends in the current locale
+func textAlignTrailing() fyne.TextAlign {
+	if i18n.RightToLeft() {
+		return fyne.TextAlignLeading
+	}
+	return fyne.TextAlignTrailing
+}
+
+// buttonAlignLeading is where button text starts in the current locale
+func buttonAlignLeading() widget.ButtonAlign {
+	if i18n.RightToLeft() {
+		return widget.ButtonAlignTrailing
+	}
+	return widget.ButtonAlignLeading
+}
+
+// mirrored returns the objects of a row in reading order
+func mirrored(objects ...fyne.CanvasObject) []fyne.CanvasObject {
+	if !i18n.RightToLeft() {
+		return objects
+	}
+	reversed := make([]fyne.CanvasObject, len(objects))
+	for i, o := range objects {
+		reversed[len(objects)-1-i] = o
+	}
+	return reversed
+}
+
+// newRow lays objects out in reading order, like container.NewHBox
+func newRow(objects ...fyne.CanvasObject) *fyne.Container {
+	return container.NewHBox(mirrored(objects...)...)
+}
+
+// newBorderRow is container.NewBorder with leading and trailing sides
+// instead of left and right
+func newBorderRow(top, bottom, leading, trailing fyne.CanvasObject, center ...fyne.CanvasObject) *fyne.Container {
+	if i18n.RightToLeft() {
+		leading, trailing = trailing, leading
+	}
+	return container.NewBorder(top, bottom, leading, trailing, center...)
+}
+
+// BackIcon is the arrow of back buttons, pointing to the reading start
+func BackIcon() fyne.Resource {
+	if i18n.RightToLeft() {
+		return theme.NavigateNextIcon()
+	}
+	return theme.NavigateBackIcon()
+}
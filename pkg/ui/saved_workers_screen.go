@@ -0,0 +1,85 @@
+package ui
+
+import (
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/widget"
+
+	"skillDar/pkg/i18n"
+)
+
+// CreateSavedWorkersScreen builds the list of workers the client saved as favorites
+func CreateSavedWorkersScreen(state AppState) fyne.CanvasObject {
+	favorites := state.Favorites()
+
+	title := newLabel(i18n.T("Saved Workers"))
+	title.Alignment = fyne.TextAlignCenter
+	title.TextStyle = fyne.TextStyle{Bold: true}
+
+	statusLabel := newLabel("")
+	statusLabel.Alignment = fyne.TextAlignCenter
+	statusLabel.Wrapping = fyne.TextWrapWord
+
+	listContainer := container.NewVBox()
+	cards := &Subscriptions{} // Listeners of the cards in the list
+
+	refreshList := func() {
+		cards.Release()
+		listContainer.Objects = nil
+		workers := favorites.List()
+		if len(workers) == 0 {
+			empty := newLabel(i18n.T("No saved workers yet.\nTap ♡ on a worker to save them here."))
+			empty.Alignment = fyne.TextAlignCenter
+			listContainer.Add(empty)
+		}
+		for _, worker := range workers {
+			w := worker
+			bookAgainBtn := widget.NewButton(i18n.T("Book Again"), func() {
+				state.ShowBooking(w)
+			})
+			bookAgainBtn.Importance = widget.HighImportance
+
+			listContainer.Add(container.NewVBox(
+				createSimpleWorkerCard(state, w, nil, cards),
+				bookAgainBtn,
+				widget.NewSeparator(),
+			))
+		}
+		listContainer.Refresh()
+	}
+
+	syncBtn := widget.NewButton("↻ "+i18n.T("Sync"), nil)
+	syncBtn.Importance = widget.LowImportance
+	syncBtn.OnTapped = func() {
+		syncBtn.Disable()
+		statusLabel.SetText(i18n.T("Syncing..."))
+		favorites.Sync(func(err error) {
+			syncBtn.Enable()
+			if err != nil {
+				// Keep showing the cached list while offline
+				_, message := StatusForError(err)
+				statusLabel.SetText(i18n.T("Showing saved copy (%s)", message))
+				return
+			}
+			statusLabel.SetText("")
+		})
+	}
+
+	removeListener := favorites.OnChanged(refreshList)
+	refreshList()
+
+	header := newBorderRow(nil, nil, nil, syncBtn, title)
+
+	screen := NewScreen(container.NewBorder(
+		container.NewVBox(header, statusLabel),
+		nil,
+		nil,
+		nil,
+		container.NewVScroll(listContainer),
+	))
+	screen.DestroyFunc = func() {
+		removeListener()
+		cards.Release()
+	}
+	return screen
+}
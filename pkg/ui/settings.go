@@ -0,0 +1,220 @@
+package ui
+
+import (
+	"strconv"
+	"strings"
+	"sync"
+
+	"fyne.io/fyne/v2"
+
+	"skillDar/pkg/i18n"
+	skilltheme "skillDar/pkg/theme"
+)
+
+// Preference keys of the user settings
+const (
+	prefSettingsTheme        = "settings.theme"
+	prefSettingsHighContrast = "settings.high_contrast"
+	prefSettingsAccent       = "settings.accent"
+	prefSettingsLanguage     = "settings.language"
+	prefSettingsNotify       = "settings.notify." // + category
+	prefSettingsDistanceUnit = "settings.distance_unit"
+	prefSettingsDataSaver    = "settings.data_saver"
+)
+
+// ThemeMode is the theme the user picked
+type ThemeMode string
+
+const (
+	ThemeSystem ThemeMode = "system" // Follow the system light or dark setting
+	ThemeLight  ThemeMode = "light"
+	ThemeDark   ThemeMode = "dark"
+)
+
+// LanguageSystem uses the language of the device
+const LanguageSystem = ""
+
+// Languages lists the selectable app languages by code, in display order
+var Languages = []struct {
+	Code string
+	Name string // In the language itself
+}{
+	{LanguageSystem, "System default"},
+	{"en", "English"},
+	{"fr", "Français"},
+	{"ar", "العربية"},
+}
+
+// NotificationCategory groups the notifications a user can turn off
+type NotificationCategory string
+
+const (
+	NotifyBookings   NotificationCategory = "bookings"   // Booking confirmations and changes
+	NotifyMessages   NotificationCategory = "messages"   // Chat messages from workers or clients
+	NotifyPayments   NotificationCategory = "payments"   // Payments, refunds and payouts
+	NotifyReminders  NotificationCategory = "reminders"  // Upcoming jobs
+	NotifyPromotions NotificationCategory = "promotions" // Offers and promo codes
+)
+
+// NotificationCategories lists the categories in display order
+var NotificationCategories = []NotificationCategory{
+	NotifyBookings, NotifyMessages, NotifyPayments, NotifyReminders, NotifyPromotions,
+}
+
+// DistanceUnit is the unit distances to workers are shown in
+type DistanceUnit string
+
+const (
+	Kilometres DistanceUnit = "km"
+	Miles      DistanceUnit = "mi"
+)
+
+// kmPerMile converts distances for DistanceUnit Miles
+const kmPerMile = 1.609344
+
+// FormatDistance shows a distance given in kilometres, e.g. "1.2 km", in the unit
+// and the number format of the app language
+func FormatDistance(km float64, unit DistanceUnit) string {
+	if unit == Miles {
+		return i18n.T("%s mi", i18n.FormatDecimal(km/kmPerMile, 1))
+	}
+	return i18n.T("%s km", i18n.FormatDecimal(km, 1))
+}
+
+// formatDistanceText converts a distance sent by the API as text, e.g. "0.8 km",
+// to the unit. Text it does not understand is returned as is.
+func formatDistanceText(text string, unit DistanceUnit) string {
+	value, ok := strings.CutSuffix(strings.TrimSpace(text), " km")
+	if !ok {
+		return text
+	}
+	km, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return text
+	}
+	return FormatDistance(km, unit)
+}
+
+// SettingsStore keeps the user settings in the app preferences so they are
+// restored at startup. Listeners apply changes right away.
+type SettingsStore struct {
+	mu        sync.Mutex
+	prefs     fyne.Preferences
+	listeners []func()
+}
+
+// NewSettingsStore creates a settings store backed by the app preferences
+func NewSettingsStore(app fyne.App) *SettingsStore {
+	return &SettingsStore{prefs: app.Preferences()}
+}
+
+// OnChanged registers a callback run on the UI thread whenever a setting changes
+func (s *SettingsStore) OnChanged(listener func()) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.listeners = append(s.listeners, listener)
+}
+
+// Theme returns the theme mode, following the system by default
+func (s *SettingsStore) Theme() ThemeMode {
+	switch mode := ThemeMode(s.prefs.String(prefSettingsTheme)); mode {
+	case ThemeLight, ThemeDark:
+		return mode
+	}
+	return ThemeSystem
+}
+
+// SetTheme changes the theme mode
+func (s *SettingsStore) SetTheme(mode ThemeMode) {
+	s.prefs.SetString(prefSettingsTheme, string(mode))
+	s.notify()
+}
+
+// HighContrast reports whether the high contrast colors are used
+func (s *SettingsStore) HighContrast() bool {
+	return s.prefs.Bool(prefSettingsHighContrast)
+}
+
+// SetHighContrast turns the high contrast colors on or off
+func (s *SettingsStore) SetHighContrast(on bool) {
+	s.prefs.SetBool(prefSettingsHighContrast, on)
+	s.notify()
+}
+
+// Accent returns the accent color, brand blue by default
+func (s *SettingsStore) Accent() skilltheme.Accent {
+	accent := skilltheme.Accent(s.prefs.String(prefSettingsAccent))
+	for _, a := range skilltheme.Accents {
+		if a == accent {
+			return accent
+		}
+	}
+	return skilltheme.AccentBlue
+}
+
+// SetAccent changes the accent color
+func (s *SettingsStore) SetAccent(accent skilltheme.Accent) {
+	s.prefs.SetString(prefSettingsAccent, string(accent))
+	s.notify()
+}
+
+// Language returns the language code, LanguageSystem to use the device language
+func (s *SettingsStore) Language() string {
+	return s.prefs.String(prefSettingsLanguage)
+}
+
+// SetLanguage changes the language, LanguageSystem uses the device language
+func (s *SettingsStore) SetLanguage(code string) {
+	s.prefs.SetString(prefSettingsLanguage, code)
+	s.notify()
+}
+
+// NotificationEnabled reports whether notifications of a category are shown,
+// all categories are on by default
+func (s *SettingsStore) NotificationEnabled(category NotificationCategory) bool {
+	return s.prefs.BoolWithFallback(prefSettingsNotify+string(category), true)
+}
+
+// SetNotificationEnabled turns notifications of a category on or off
+func (s *SettingsStore) SetNotificationEnabled(category NotificationCategory, enabled bool) {
+	s.prefs.SetBool(prefSettingsNotify+string(category), enabled)
+	s.notify()
+}
+
+// DistanceUnit returns the unit distances are shown in, kilometres by default
+func (s *SettingsStore) DistanceUnit() DistanceUnit {
+	if DistanceUnit(s.prefs.String(prefSettingsDistanceUnit)) == Miles {
+		return Miles
+	}
+	return Kilometres
+}
+
+// SetDistanceUnit changes the unit distances are shown in
+func (s *SettingsStore) SetDistanceUnit(unit DistanceUnit) {
+	s.prefs.SetString(prefSettingsDistanceUnit, string(unit))
+	s.notify()
+}
+
+// DataSaver reports whether the app should limit its mobile data use
+func (s *SettingsStore) DataSaver() bool {
+	return s.prefs.Bool(prefSettingsDataSaver)
+}
+
+// SetDataSaver turns data saver mode on or off
+func (s *SettingsStore) SetDataSaver(on bool) {
+	s.prefs.SetBool(prefSettingsDataSaver, on)
+	imageLoader().CacheFirst.Store(on) // Cached pictures are not checked for updates
+	s.notify()
+}
+
+func (s *SettingsStore) notify() {
+	s.mu.Lock()
+	listeners := append([]func(){}, s.listeners...)
+	s.mu.Unlock()
+
+	fyne.Do(func() {
+		for _, listener := range listeners {
+			listener()
+		}
+	})
+}
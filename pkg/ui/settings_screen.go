@@ -0,0 +1,212 @@
+package ui
+
+import (
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/dialog"
+	"fyne.io/fyne/v2/widget"
+
+	"skillDar/pkg/i18n"
+	skilltheme "skillDar/pkg/theme"
+)
+
+// Support contacts shown in Help & Support
+const (
+	supportEmail = "support@skilldar.tn"
+	supportPhone = "+216 71 000 000"
+)
+
+// themeModeLabels are the theme choices in display order, in English.
+// The labels of these tables are translated where they are shown.
+var themeModeLabels = []struct {
+	Mode  ThemeMode
+	Label string
+}{
+	{ThemeLight, "Light"},
+	{ThemeDark, "Dark"},
+	{ThemeSystem, "Follow system"},
+}
+
+// accentLabels name the accent colors
+var accentLabels = map[skilltheme.Accent]string{
+	skilltheme.AccentBlue:   "Blue",
+	skilltheme.AccentGreen:  "Green",
+	skilltheme.AccentOrange: "Orange",
+	skilltheme.AccentPurple: "Purple",
+	skilltheme.AccentTeal:   "Teal",
+}
+
+// notificationCategoryLabels describe each notification category
+var notificationCategoryLabels = map[NotificationCategory]string{
+	NotifyBookings:   "Bookings: confirmations and changes",
+	NotifyMessages:   "Messages from workers and clients",
+	NotifyPayments:   "Payments, refunds and payouts",
+	NotifyReminders:  "Reminders before a job",
+	NotifyPromotions: "Offers and promo codes",
+}
+
+// CreateSettingsScreen builds the app settings screen.
+// Every change is saved and applied right away.
+func CreateSettingsScreen(state AppState) fyne.CanvasObject {
+	settings := state.Settings()
+
+	title := newLabel(i18n.T("Settings"))
+	title.Alignment = fyne.TextAlignCenter
+	title.TextStyle = fyne.TextStyle{Bold: true}
+
+	sectionLabel := func(text string) *widget.Label {
+		label := newLabel(text)
+		label.TextStyle = fyne.TextStyle{Bold: true}
+		return label
+	}
+	hint := func(text string) *widget.Label {
+		label := newLabel(text)
+		label.Wrapping = fyne.TextWrapWord
+		label.Importance = widget.LowImportance
+		return label
+	}
+
+	// Theme
+	var themeOptions []string
+	for _, t := range themeModeLabels {
+		themeOptions = append(themeOptions, i18n.T(t.Label))
+	}
+	themeRadio := widget.NewRadioGroup(themeOptions, nil)
+	for _, t := range themeModeLabels {
+		if t.Mode == settings.Theme() {
+			themeRadio.SetSelected(i18n.T(t.Label))
+		}
+	}
+	themeRadio.OnChanged = func(selected string) {
+		for _, t := range themeModeLabels {
+			if i18n.T(t.Label) == selected {
+				settings.SetTheme(t.Mode)
+			}
+		}
+	}
+	themeRadio.Required = true
+	// The theme can also be switched from the profile tab
+	settings.OnChanged(func() {
+		for _, t := range themeModeLabels {
+			if t.Mode == settings.Theme() {
+				themeRadio.SetSelected(i18n.T(t.Label))
+			}
+		}
+	})
+
+	// High contrast and accent color
+	highContrastCheck := widget.NewCheck(i18n.T("High contrast"), settings.SetHighContrast)
+	highContrastCheck.SetChecked(settings.HighContrast())
+
+	var accentOptions []string
+	for _, a := range skilltheme.Accents {
+		accentOptions = append(accentOptions, i18n.T(accentLabels[a]))
+	}
+	accentRadio := widget.NewRadioGroup(accentOptions, nil)
+	accentRadio.SetSelected(i18n.T(accentLabels[settings.Accent()]))
+	accentRadio.OnChanged = func(selected string) {
+		for _, a := range skilltheme.Accents {
+			if i18n.T(accentLabels[a]) == selected {
+				settings.SetAccent(a)
+			}
+		}
+	}
+	accentRadio.Required = true
+
+	// Language
+	var languageOptions []string
+	for _, l := range Languages {
+		languageOptions = append(languageOptions, languageName(l.Code, l.Name))
+	}
+	languageSelect := widget.NewSelect(languageOptions, nil)
+	for _, l := range Languages {
+		if l.Code == settings.Language() {
+			languageSelect.SetSelected(languageName(l.Code, l.Name))
+		}
+	}
+	languageSelect.OnChanged = func(selected string) {
+		for _, l := range Languages {
+			if languageName(l.Code, l.Name) == selected {
+				settings.SetLanguage(l.Code)
+			}
+		}
+	}
+
+	// Notifications
+	notifications := container.NewVBox()
+	for _, category := range NotificationCategories {
+		c := category
+		check := widget.NewCheck(i18n.T(notificationCategoryLabels[c]), func(on bool) {
+			settings.SetNotificationEnabled(c, on)
+		})
+		check.SetChecked(settings.NotificationEnabled(c))
+		notifications.Add(check)
+	}
+
+	// Distance units
+	kilometres, miles := i18n.T("Kilometres"), i18n.T("Miles")
+	unitRadio := widget.NewRadioGroup([]string{kilometres, miles}, func(selected string) {
+		if selected == miles {
+			settings.SetDistanceUnit(Miles)
+		} else {
+			settings.SetDistanceUnit(Kilometres)
+		}
+	})
+	unitRadio.Horizontal = true
+	unitRadio.Required = true
+	if settings.DistanceUnit() == Miles {
+		unitRadio.SetSelected(miles)
+	} else {
+		unitRadio.SetSelected(kilometres)
+	}
+
+	// Data saver
+	dataSaverCheck := widget.NewCheck(i18n.T("Data saver"), settings.SetDataSaver)
+	dataSaverCheck.SetChecked(settings.DataSaver())
+
+	helpBtn := widget.NewButton(i18n.T("Help & Support"), func() {
+		showHelp(state)
+	})
+	helpBtn.Alignment = buttonAlignLeading()
+
+	content := container.NewVBox(
+		title,
+		sectionLabel(i18n.T("Appearance")),
+		themeRadio,
+		highContrastCheck,
+		hint(i18n.T("Stronger colors and borders that are easier to read.")),
+		sectionLabel(i18n.T("Accent Color")),
+		accentRadio,
+		sectionLabel(i18n.T("Language")),
+		languageSelect,
+		sectionLabel(i18n.T("Notifications")),
+		notifications,
+		sectionLabel(i18n.T("Distance Units")),
+		unitRadio,
+		sectionLabel(i18n.T("Mobile Data")),
+		dataSaverCheck,
+		hint(i18n.T("Uses saved copies of pictures and checks for new messages less often.")),
+		widget.NewSeparator(),
+		helpBtn,
+	)
+
+	return container.NewVScroll(content)
+}
+
+// languageName is the name of a language shown in the picker. Languages are
+// named in themselves, only the system default is translated.
+func languageName(code, name string) string {
+	if code == LanguageSystem {
+		return i18n.T(name)
+	}
+	return name
+}
+
+// showHelp shows how to reach customer support
+func showHelp(state AppState) {
+	message := i18n.T("Questions about a booking or a payment?") + "\n\n" +
+		i18n.T("Email: %s", supportEmail) + "\n" +
+		i18n.T("Phone: %s", supportPhone) + "\n\n" +
+		i18n.T("We answer every day from 8:00 to 20:00.")
+	dialog.ShowInformation(i18n.T("Help & Support"), message, state.GetWindow())
+}
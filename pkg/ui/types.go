@@ -1,6 +1,11 @@
 package ui
 
-import "fyne.io/fyne/v2"
+import (
+	"fyne.io/fyne/v2"
+
+	"skillDar/pkg/assets"
+	"skillDar/pkg/router"
+)
 
 // AppState defines the interface for app state management
 // This allows screens to access navigation and app-level state
@@ -8,9 +13,15 @@ import "fyne.io/fyne/v2"
 // It provides methods for screen navigation, theme management, user role handling, and resource access.
 // Implementations of this interface should ensure thread-safe operations when called from multiple goroutines.
 type AppState interface {
-	ShowScreen(screenName string)
-	ShowWorkerProfile(worker WorkerProfile) //
-	GetImage(name string) fyne.Resource
+	Navigate(route router.Route)            // Push a screen on the back stack
+	ResetTo(route router.Route)             // Clear the back stack, e.g. after login
+	GoBack()                                // Return to the previous screen
+	OpenLink(link string)                   // Show the screen a deep link points to
+	CompleteLogin()                         // Show main and any link that waited for login
+	Logout()                                // Forget the user's data and show the welcome screen
+	ShowWorkerProfile(worker WorkerProfile) // Navigate to a worker's profile
+	ShowBooking(worker WorkerProfile)       // Navigate to the booking form for a worker
+	GetImage(key assets.Key) fyne.Resource
 	SetUserRole(role string)
 	GetUserRole() string
 	ToggleTheme()
@@ -18,4 +29,10 @@ type AppState interface {
 	IsDarkTheme() bool
 	ShowConnectionError(status ConnectionStatus, message string)
 	HideConnectionError()
+	Notifications() *NotificationManager
+	Favorites() *FavoritesStore
+	Profile() *ProfileStore
+	Addresses() *AddressStore
+	Settings() *SettingsStore
+	GetWindow() fyne.Window
 }
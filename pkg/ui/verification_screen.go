@@ -0,0 +1,333 @@
+package ui
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/dialog"
+	"fyne.io/fyne/v2/layout"
+	"fyne.io/fyne/v2/storage"
+	"fyne.io/fyne/v2/widget"
+
+	"skillDar/pkg/i18n"
+)
+
+// maxVerificationFileSize is the largest document accepted for upload
+const maxVerificationFileSize = 10 << 20 // 10 MB
+
+// VerificationStatus is the review state of a worker's identity verification
+type VerificationStatus string
+
+const (
+	VerificationNone     VerificationStatus = ""         // Nothing submitted yet
+	VerificationPending  VerificationStatus = "pending"  // Waiting for review
+	VerificationApproved VerificationStatus = "approved" // Identity confirmed
+	VerificationRejected VerificationStatus = "rejected" // Refused, see Reason
+)
+
+// DocumentKind identifies what a verification document proves
+type DocumentKind string
+
+const (
+	DocumentID          DocumentKind = "id_document"
+	DocumentSelfie      DocumentKind = "selfie"
+	DocumentCertificate DocumentKind = "certificate"
+)
+
+// VerificationDocument is an uploaded verification file
+type VerificationDocument struct {
+	ID   string       `json:"id"`
+	Kind DocumentKind `json:"kind"`
+	Name string       `json:"name"`
+}
+
+// Verification is the current verification request of the signed-in worker
+type Verification struct {
+	Status      VerificationStatus     `json:"status"`
+	Reason      string                 `json:"reason,omitempty"` // Set when rejected
+	SubmittedAt time.Time              `json:"submitted_at"`
+	Documents   []VerificationDocument `json:"documents"`
+}
+
+// FetchVerification returns the signed-in worker's verification status
+func FetchVerification(config *APIConfig) (Verification, error) {
+	var v Verification
+	err := APIRequestJSON(config, http.MethodGet, "/worker/verification", nil, &v)
+	return v, err
+}
+
+// UploadVerificationDocument uploads one document of the given kind
+func UploadVerificationDocument(config *APIConfig, kind DocumentKind, filename string, content io.Reader) (VerificationDocument, error) {
+	var doc VerificationDocument
+	path := "/worker/verification/documents?kind=" + url.QueryEscape(string(kind))
+	err := APIUploadFile(config, path, "document", filename, content, &doc)
+	return doc, err
+}
+
+// SubmitVerification sends the uploaded documents for review
+func SubmitVerification(config *APIConfig, documents []VerificationDocument) (Verification, error) {
+	ids := make([]string, len(documents))
+	for i, doc := range documents {
+		ids[i] = doc.ID
+	}
+	var v Verification
+	err := APIRequestJSON(config, http.MethodPost, "/worker/verification", map[string][]string{"document_ids": ids}, &v)
+	return v, err
+}
+
+// verificationStep describes one page of the guided upload flow
+type verificationStep struct {
+	kind     DocumentKind
+	name     string
+	hint     string
+	multiple bool // certificates accept several files and are optional
+}
+
+func verificationSteps() []verificationStep {
+	return []verificationStep{
+		{DocumentID, i18n.T("Identity Document"), i18n.T("Upload a clear photo of the front of your national ID card (CIN) or passport."), false},
+		{DocumentSelfie, i18n.T("Selfie"), i18n.T("Take a selfie holding your ID document next to your face, in good light."), false},
+		{DocumentCertificate, i18n.T("Trade Certificates"), i18n.T("Add diplomas or certificates for your trade. This step is optional but earns a certificate count on your profile."), true},
+	}
+}
+
+// CreateVerificationScreen builds the worker verification status and upload flow
+func CreateVerificationScreen(state AppState) fyne.CanvasObject {
+	apiConfig := DefaultAPIConfig()
+
+	title := newLabel(i18n.T("Verification"))
+	title.Alignment = fyne.TextAlignCenter
+	title.TextStyle = fyne.TextStyle{Bold: true}
+
+	body := container.NewStack()
+
+	var showStatus func(v Verification)
+	var showFlow func(firstStep int)
+
+	// showFlow runs the guided upload starting at the given step,
+	// verified workers only add certificates
+	showFlow = func(firstStep int) {
+		steps := verificationSteps()[firstStep:]
+		uploaded := make(map[DocumentKind][]VerificationDocument)
+		current := 0
+
+		stepTitle := newLabel("")
+		stepTitle.TextStyle = fyne.TextStyle{Bold: true}
+		stepHint := newLabel("")
+		stepHint.Wrapping = fyne.TextWrapWord
+		filesBox := container.NewVBox()
+		stepStatus := newLabel("")
+		stepStatus.Wrapping = fyne.TextWrapWord
+		progress := widget.NewProgressBar()
+
+		var backBtn, nextBtn, uploadBtn *widget.Button
+
+		var render func()
+		render = func() {
+			progress.SetValue(float64(current) / float64(len(steps)))
+			filesBox.Objects = nil
+
+			if current == len(steps) {
+				// Review page
+				stepTitle.SetText(fmt.Sprintf("%d. %s", len(steps)+1, i18n.T("Review & Submit")))
+				stepHint.SetText(i18n.T("Check your documents, then submit them for review. We usually answer within 48 hours."))
+				for _, step := range steps {
+					for _, doc := range uploaded[step.kind] {
+						filesBox.Add(newLabel("✓ " + step.name + ": " + doc.Name))
+					}
+				}
+				uploadBtn.Hide()
+				nextBtn.SetText(i18n.T("Submit for Review"))
+			} else {
+				step := steps[current]
+				stepTitle.SetText(fmt.Sprintf("%d. %s", current+1, step.name))
+				stepHint.SetText(step.hint)
+				for _, doc := range uploaded[step.kind] {
+					filesBox.Add(newLabel("📄 " + doc.Name))
+				}
+				uploadBtn.Show()
+				if step.multiple {
+					uploadBtn.SetText("＋ " + i18n.T("Add File"))
+				} else {
+					uploadBtn.SetText(i18n.T("Choose File"))
+				}
+				nextBtn.SetText(i18n.T("Next"))
+			}
+
+			if current == 0 {
+				backBtn.Disable()
+			} else {
+				backBtn.Enable()
+			}
+			filesBox.Refresh()
+		}
+
+		uploadBtn = widget.NewButton(i18n.T("Choose File"), func() {
+			step := steps[current]
+			picker := dialog.NewFileOpen(func(reader fyne.URIReadCloser, err error) {
+				if err != nil || reader == nil {
+					return
+				}
+				data, err := io.ReadAll(io.LimitReader(reader, maxVerificationFileSize+1))
+				name := reader.URI().Name()
+				reader.Close()
+				if err != nil {
+					stepStatus.SetText("⚠ " + i18n.T("Could not read the file"))
+					return
+				}
+				if len(data) > maxVerificationFileSize {
+					stepStatus.SetText("⚠ " + i18n.T("Files must be 10 MB or smaller"))
+					return
+				}
+
+				stepStatus.SetText(i18n.T("Uploading %s...", name))
+				uploadBtn.Disable()
+				go func() {
+					doc, err := UploadVerificationDocument(apiConfig, step.kind, name, bytes.NewReader(data))
+					fyne.Do(func() {
+						uploadBtn.Enable()
+						stepStatus.SetText("")
+						if err != nil {
+							state.ShowConnectionError(StatusForError(err))
+							return
+						}
+						if doc.Name == "" {
+							doc.Name = name
+						}
+						if step.multiple {
+							uploaded[step.kind] = append(uploaded[step.kind], doc)
+						} else {
+							uploaded[step.kind] = []VerificationDocument{doc}
+						}
+						render()
+					})
+				}()
+			}, state.GetWindow())
+			picker.SetFilter(storage.NewExtensionFileFilter([]string{".jpg", ".jpeg", ".png", ".pdf"}))
+			picker.Show()
+		})
+
+		backBtn = widget.NewButton(i18n.T("Back"), func() {
+			current--
+			stepStatus.SetText("")
+			render()
+		})
+
+		nextBtn = widget.NewButton(i18n.T("Next"), func() {
+			if current < len(steps) {
+				step := steps[current]
+				if !step.multiple && len(uploaded[step.kind]) == 0 {
+					stepStatus.SetText("⚠ " + i18n.T("Please upload this document to continue"))
+					return
+				}
+				current++
+				stepStatus.SetText("")
+				render()
+				return
+			}
+
+			// Submit
+			var documents []VerificationDocument
+			for _, step := range steps {
+				documents = append(documents, uploaded[step.kind]...)
+			}
+			nextBtn.Disable()
+			stepStatus.SetText(i18n.T("Submitting..."))
+			go func() {
+				v, err := SubmitVerification(apiConfig, documents)
+				fyne.Do(func() {
+					nextBtn.Enable()
+					if err != nil {
+						stepStatus.SetText("")
+						state.ShowConnectionError(StatusForError(err))
+						return
+					}
+					if v.Status == VerificationNone {
+						v.Status = VerificationPending
+					}
+					showStatus(v)
+				})
+			}()
+		})
+		nextBtn.Importance = widget.HighImportance
+
+		render()
+
+		body.Objects = []fyne.CanvasObject{container.NewVBox(
+			progress,
+			stepTitle,
+			stepHint,
+			filesBox,
+			uploadBtn,
+			stepStatus,
+			layout.NewSpacer(),
+			container.NewGridWithColumns(2, backBtn, nextBtn),
+		)}
+		body.Refresh()
+	}
+
+	showStatus = func(v Verification) {
+		statusTitle := newLabel("")
+		statusTitle.TextStyle = fyne.TextStyle{Bold: true}
+		statusTitle.Alignment = fyne.TextAlignCenter
+		details := newLabel("")
+		details.Wrapping = fyne.TextWrapWord
+		details.Alignment = fyne.TextAlignCenter
+
+		actionBtn := widget.NewButton(i18n.T("Start Verification"), func() { showFlow(0) })
+		actionBtn.Importance = widget.HighImportance
+
+		switch v.Status {
+		case VerificationPending:
+			statusTitle.SetText("⏳ " + i18n.T("Under Review"))
+			statusTitle.Importance = widget.WarningImportance
+			details.SetText(i18n.T("Submitted on %s. We will notify you once your documents have been reviewed.",
+				i18n.FormatDate(v.SubmittedAt, "02 Jan 2006")))
+			actionBtn.Hide()
+		case VerificationApproved:
+			statusTitle.SetText("✓ " + i18n.T("Verified"))
+			statusTitle.Importance = widget.SuccessImportance
+			details.SetText(i18n.T("Your identity is confirmed. Clients now see the verified badge on your profile."))
+			actionBtn.SetText(i18n.T("Add Certificates"))
+			actionBtn.OnTapped = func() { showFlow(len(verificationSteps()) - 1) }
+		case VerificationRejected:
+			statusTitle.SetText("✕ " + i18n.T("Not Approved"))
+			statusTitle.Importance = widget.DangerImportance
+			reason := v.Reason
+			if reason == "" {
+				reason = i18n.T("No reason given")
+			}
+			details.SetText(i18n.T("Reason: %s", reason) + "\n" + i18n.T("Please upload new documents and submit again."))
+			actionBtn.SetText(i18n.T("Resubmit Documents"))
+		default:
+			statusTitle.SetText(i18n.T("Not Verified"))
+			details.SetText(i18n.T("Verified workers get more bookings. Upload your ID, a selfie and your trade certificates to get the verified badge."))
+		}
+
+		body.Objects = []fyne.CanvasObject{container.NewVBox(
+			statusTitle,
+			details,
+			actionBtn,
+		)}
+		body.Refresh()
+	}
+
+	body.Objects = []fyne.CanvasObject{newLabel(i18n.T("Loading..."))}
+	go func() {
+		v, err := FetchVerification(apiConfig)
+		fyne.Do(func() {
+			if err != nil {
+				state.ShowConnectionError(StatusForError(err))
+			}
+			showStatus(v)
+		})
+	}()
+
+	return container.NewBorder(title, nil, nil, nil, container.NewVScroll(container.NewPadded(body)))
+}
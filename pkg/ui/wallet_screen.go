@@ -0,0 +1,245 @@
+package ui
+
+import (
+	"context"
+	"net/http"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/dialog"
+	"fyne.io/fyne/v2/widget"
+
+	"skillDar/pkg/billing"
+	"skillDar/pkg/i18n"
+	"skillDar/pkg/money"
+)
+
+// Wallet top-up limits
+var (
+	minTopUp = money.Dinars(10)
+	maxTopUp = money.Dinars(1000)
+)
+
+// TopUp is a pending wallet credit purchase, paid like an order
+type TopUp struct {
+	ID     string      `json:"id"`
+	Amount money.Money `json:"amount"`
+}
+
+// FetchWallet returns the signed-in client's wallet balance and ledger
+func FetchWallet(config *APIConfig) (billing.Wallet, error) {
+	var wallet billing.Wallet
+	err := APIRequestJSON(config, http.MethodGet, "/wallet", nil, &wallet)
+	return wallet, err
+}
+
+// CreateTopUp creates a top-up to be paid through a payment provider
+func CreateTopUp(config *APIConfig, amount money.Money) (TopUp, error) {
+	var topUp TopUp
+	err := APIRequestJSON(config, http.MethodPost, "/wallet/topups", map[string]money.Money{"amount": amount}, &topUp)
+	return topUp, err
+}
+
+// transactionKindLabels names the ledger entry kinds in English, translated
+// they are also the filter options
+var transactionKindLabels = map[billing.TransactionKind]string{
+	billing.TransactionTopUp:       "Top-ups",
+	billing.TransactionPayment:     "Payments",
+	billing.TransactionRefund:      "Refunds",
+	billing.TransactionPromoCredit: "Promo credits",
+	billing.TransactionReferral:    "Referral rewards",
+}
+
+// transactionIcon returns the icon shown in front of a ledger entry
+func transactionIcon(kind billing.TransactionKind) string {
+	switch kind {
+	case billing.TransactionTopUp:
+		return "⬆"
+	case billing.TransactionPayment:
+		return "🛠"
+	case billing.TransactionRefund:
+		return "↩"
+	case billing.TransactionPromoCredit:
+		return "🎁"
+	case billing.TransactionReferral:
+		return "🤝"
+	}
+	return "•"
+}
+
+// createTransactionRow shows one wallet ledger entry
+func createTransactionRow(tx billing.Transaction) fyne.CanvasObject {
+	description := newLabel(transactionIcon(tx.Kind) + " " + tx.Description + "\n" +
+		i18n.FormatDate(tx.CreatedAt, "02 Jan 2006 15:04"))
+	description.Wrapping = fyne.TextWrapWord
+
+	amount := newLabel(tx.Amount.String())
+	amount.TextStyle = fyne.TextStyle{Bold: true}
+	if tx.Amount.IsNegative() {
+		amount.Importance = widget.DangerImportance
+	} else {
+		amount.SetText("+" + tx.Amount.String())
+		amount.Importance = widget.SuccessImportance
+	}
+
+	return newBorderRow(nil, nil, nil, amount, description)
+}
+
+// CreateWalletScreen shows the wallet balance, the ledger and the top-up form
+func CreateWalletScreen(state AppState) fyne.CanvasObject {
+	apiConfig := DefaultAPIConfig()
+	providers := PaymentProviders(apiConfig)
+	// Cancelled when the screen is dropped, to stop waiting for a payment
+	ctx, cancel := context.WithCancel(context.Background())
+
+	title := newLabel(i18n.T("Wallet"))
+	title.Alignment = fyne.TextAlignCenter
+	title.TextStyle = fyne.TextStyle{Bold: true}
+
+	var wallet billing.Wallet
+
+	balanceLabel := newLabel("-")
+	balanceLabel.Alignment = fyne.TextAlignCenter
+	balanceLabel.TextStyle = fyne.TextStyle{Bold: true}
+	balanceCard := widget.NewCard(i18n.T("Balance"), "", balanceLabel)
+
+	// Ledger with a filter by entry kind
+	all := i18n.T("All")
+	filterOptions := []string{all}
+	for _, kind := range []billing.TransactionKind{
+		billing.TransactionTopUp, billing.TransactionPayment,
+		billing.TransactionRefund, billing.TransactionPromoCredit, billing.TransactionReferral,
+	} {
+		filterOptions = append(filterOptions, i18n.T(transactionKindLabels[kind]))
+	}
+	filterSelect := widget.NewSelect(filterOptions, nil)
+	ledgerBox := container.NewVBox(newLabel(i18n.T("Loading...")))
+
+	renderLedger := func() {
+		ledgerBox.Objects = nil
+		for _, tx := range wallet.Transactions {
+			if filterSelect.Selected != all && i18n.T(transactionKindLabels[tx.Kind]) != filterSelect.Selected {
+				continue
+			}
+			ledgerBox.Add(createTransactionRow(tx))
+			ledgerBox.Add(widget.NewSeparator())
+		}
+		if len(ledgerBox.Objects) == 0 {
+			ledgerBox.Add(newLabel(i18n.T("No transactions yet")))
+		}
+		ledgerBox.Refresh()
+	}
+	filterSelect.OnChanged = func(string) { renderLedger() }
+
+	var load func()
+	load = func() {
+		go func() {
+			result, err := FetchWallet(apiConfig)
+			fyne.Do(func() {
+				if err != nil {
+					state.ShowConnectionError(StatusForError(err))
+					ledgerBox.Objects = []fyne.CanvasObject{newLabel(i18n.T("Could not load the wallet"))}
+					ledgerBox.Refresh()
+					return
+				}
+				wallet = result
+				balanceLabel.SetText(wallet.Balance.String())
+				renderLedger()
+			})
+		}()
+	}
+
+	// Top-up, paid by card through the hosted checkout
+	amountEntry := widget.NewEntry()
+	amountEntry.SetPlaceHolder(i18n.T("Amount (TND)"))
+	presets := container.NewGridWithColumns(3)
+	for _, preset := range []money.Money{money.Dinars(20), money.Dinars(50), money.Dinars(100)} {
+		amount := preset
+		presets.Add(widget.NewButton(amount.Compact(money.Locale()), func() {
+			amountEntry.SetText(amount.Decimal())
+		}))
+	}
+	topUpStatus := newLabel("")
+	topUpStatus.Wrapping = fyne.TextWrapWord
+
+	var topUpBtn *widget.Button
+	topUpBtn = widget.NewButton(i18n.T("Top Up by Card"), func() {
+		amount, err := money.Parse(amountEntry.Text, money.TND)
+		if err != nil || amount.Cmp(minTopUp) < 0 || amount.Cmp(maxTopUp) > 0 {
+			topUpStatus.SetText("⚠ " + i18n.T("Enter an amount between %s and %s", minTopUp, maxTopUp))
+			return
+		}
+		provider, err := providers.Get(billing.MethodCard)
+		if err != nil {
+			topUpStatus.SetText("⚠ " + i18n.T("Card payments are not available"))
+			return
+		}
+
+		topUpBtn.Disable()
+		topUpStatus.SetText(i18n.T("Preparing top-up..."))
+		go func() {
+			topUp, err := CreateTopUp(apiConfig, amount)
+			var payment billing.Payment
+			if err == nil {
+				checkout := billing.Checkout{
+					Provider: provider,
+					OpenURL:  openCheckoutURL,
+					OnStatus: func(p billing.Payment) {
+						fyne.Do(func() { topUpStatus.SetText(paymentStatusText(p)) })
+					},
+				}
+				payment, err = checkout.PayAndConfirm(ctx, topUp.ID, amount)
+			}
+			if ctx.Err() != nil {
+				return // The screen was closed
+			}
+			fyne.Do(func() {
+				topUpBtn.Enable()
+				if err != nil {
+					if payment.ID != "" {
+						topUpStatus.SetText(paymentStatusText(payment))
+					} else {
+						topUpStatus.SetText("")
+						state.ShowConnectionError(StatusForError(err))
+					}
+					return
+				}
+				amountEntry.SetText("")
+				topUpStatus.SetText("")
+				dialog.ShowInformation(i18n.T("Wallet Topped Up"), i18n.T("%s was added to your wallet.", amount.String()), state.GetWindow())
+				load()
+			})
+		}()
+	})
+	topUpBtn.Importance = widget.HighImportance
+
+	topUpLabel := newLabel(i18n.T("Add Credit"))
+	topUpLabel.TextStyle = fyne.TextStyle{Bold: true}
+	historyLabel := newLabel(i18n.T("Transactions"))
+	historyLabel.TextStyle = fyne.TextStyle{Bold: true}
+
+	filterSelect.SetSelected(all)
+	load()
+
+	content := container.NewVBox(
+		balanceCard,
+		topUpLabel,
+		presets,
+		amountEntry,
+		topUpBtn,
+		topUpStatus,
+		widget.NewSeparator(),
+		newBorderRow(nil, nil, nil, filterSelect, historyLabel),
+		ledgerBox,
+	)
+
+	// The balance changes on other screens, e.g. when a booking is paid from the wallet
+	screen := NewScreen(container.NewBorder(title, nil, nil, nil, container.NewVScroll(container.NewPadded(content))))
+	screen.ShowFunc = func() {
+		if screen.Revisited() {
+			load()
+		}
+	}
+	screen.DestroyFunc = cancel
+	return screen
+}
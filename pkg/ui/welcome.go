@@ -6,28 +6,32 @@ import (
 	"fyne.io/fyne/v2/container"
 	"fyne.io/fyne/v2/layout"
 	"fyne.io/fyne/v2/widget"
+
+	"skillDar/pkg/assets"
+	"skillDar/pkg/i18n"
+	"skillDar/pkg/router"
 )
 
 // CreateWelcomeScreen creates the welcome screen UI
 // onGetStarted is a callback function that runs when "Get Started" button is clicked
 func CreateWelcomeScreen(state AppState) fyne.CanvasObject {
 	// UI elements
-	title := widget.NewLabel("Welcome to SkillDar")
+	title := newLabel(i18n.T("Welcome to SkillDar"))
 	title.Alignment = fyne.TextAlignCenter
 	title.TextStyle = fyne.TextStyle{Bold: true}
 
-	subtitle := widget.NewLabel("Your Home, Our Expertise")
+	subtitle := newLabel(i18n.T("Your Home, Our Expertise"))
 	subtitle.Alignment = fyne.TextAlignCenter
 
-	getStartedBtn := widget.NewButton("Get Started", func() {
-		state.ShowScreen("login")
+	getStartedBtn := widget.NewButton(i18n.T("Get Started"), func() {
+		state.Navigate(router.To(router.Login))
 	})
 
 	getStartedBtn.Importance = widget.HighImportance
 
 	//sigle image
 
-	logoImage := canvas.NewImageFromResource(state.GetImage("logoImage"))
+	logoImage := canvas.NewImageFromResource(state.GetImage(assets.Logo))
 	logoImage.FillMode = canvas.ImageFillContain
 	logoImage.SetMinSize(fyne.NewSize(270, 200))
 
@@ -0,0 +1,267 @@
+package ui
+
+import (
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/layout"
+	"fyne.io/fyne/v2/widget"
+
+	"skillDar/pkg/i18n"
+	"skillDar/pkg/schedule"
+)
+
+// createWorkerHomeContent creates the dashboard shown to workers on the home tab
+func createWorkerHomeContent(state AppState) fyne.CanvasObject {
+	apiConfig := DefaultAPIConfig()
+
+	title := newLabel(i18n.T("My Dashboard"))
+	title.TextStyle = fyne.TextStyle{Bold: true}
+	title.Alignment = fyne.TextAlignCenter
+
+	// Availability toggle
+	var availabilityCheck *widget.Check
+	var onAvailabilityChanged func(bool)
+	onAvailabilityChanged = func(available bool) {
+		availabilityCheck.Disable()
+		go func() {
+			err := SetWorkerAvailability(apiConfig, available)
+			fyne.Do(func() {
+				availabilityCheck.Enable()
+				if err != nil {
+					state.ShowConnectionError(StatusForError(err))
+					// Revert without triggering another update
+					availabilityCheck.OnChanged = nil
+					availabilityCheck.SetChecked(!available)
+					availabilityCheck.OnChanged = onAvailabilityChanged
+				}
+			})
+		}()
+	}
+	// Disabled until the current availability is known, so loading it does
+	// not send it back
+	availabilityCheck = widget.NewCheck(i18n.T("Available for new jobs"), nil)
+	availabilityCheck.Disable()
+	loadAvailability := func() {
+		go func() {
+			profile, err := FetchMyWorkerProfile(apiConfig)
+			fyne.Do(func() {
+				if err != nil {
+					state.ShowConnectionError(StatusForError(err))
+					return
+				}
+				availabilityCheck.OnChanged = nil
+				availabilityCheck.SetChecked(profile.Available)
+				availabilityCheck.OnChanged = onAvailabilityChanged
+				availabilityCheck.Enable()
+			})
+		}()
+	}
+
+	// Earnings summary
+	todayCard := createStatCard("📅", "-", i18n.T("Today"))
+	weekCard := createStatCard("🗓", "-", i18n.T("This Week"))
+	monthCard := createStatCard("💰", "-", i18n.T("This Month"))
+	earningsRow := container.NewGridWithColumns(3, todayCard, weekCard, monthCard)
+
+	earningsLabel := newLabel(i18n.T("Earnings"))
+	earningsLabel.TextStyle = fyne.TextStyle{Bold: true}
+
+	// Incoming job requests
+	requestsLabel := newLabel(i18n.T("Incoming Requests"))
+	requestsLabel.TextStyle = fyne.TextStyle{Bold: true}
+	requestsContainer := container.NewVBox(newLabel(i18n.T("Loading...")))
+
+	// Today's schedule
+	scheduleLabel := newLabel(i18n.T("Today's Schedule"))
+	scheduleLabel.TextStyle = fyne.TextStyle{Bold: true}
+	scheduleContainer := container.NewVBox(newLabel(i18n.T("Loading...")))
+
+	var loadRequests, loadSchedule func()
+
+	loadRequests = func() {
+		go func() {
+			orders, err := FetchWorkerJobs(apiConfig, OrderPending, time.Time{})
+			fyne.Do(func() {
+				requestsContainer.Objects = nil
+				if err != nil {
+					state.ShowConnectionError(StatusForError(err))
+					requestsContainer.Add(newLabel(i18n.T("Could not load requests")))
+				} else if len(orders) == 0 {
+					requestsContainer.Add(newLabel(i18n.T("No incoming requests")))
+				}
+				for _, order := range orders {
+					requestsContainer.Add(createJobRequestCard(state, order, func() {
+						loadRequests()
+						loadSchedule()
+					}))
+				}
+				requestsContainer.Refresh()
+			})
+		}()
+	}
+
+	loadSchedule = func() {
+		go func() {
+			orders, err := FetchWorkerJobs(apiConfig, OrderAccepted, time.Now().In(schedule.Location()))
+			fyne.Do(func() {
+				scheduleContainer.Objects = nil
+				if err != nil {
+					scheduleContainer.Add(newLabel(i18n.T("Could not load schedule")))
+				} else if len(orders) == 0 {
+					scheduleContainer.Add(newLabel(i18n.T("Nothing scheduled today")))
+				}
+				for _, order := range orders {
+					scheduleContainer.Add(createScheduleRow(order))
+				}
+				scheduleContainer.Refresh()
+			})
+		}()
+	}
+
+	loadEarnings := func() {
+		go func() {
+			earnings, err := FetchWorkerEarnings(apiConfig)
+			if err != nil {
+				return
+			}
+			fyne.Do(func() {
+				earningsRow.Objects = []fyne.CanvasObject{
+					createStatCard("📅", earnings.Today.String(), i18n.T("Today")),
+					createStatCard("🗓", earnings.Week.String(), i18n.T("This Week")),
+					createStatCard("💰", earnings.Month.String(), i18n.T("This Month")),
+				}
+				earningsRow.Refresh()
+			})
+		}()
+	}
+
+	loadAvailability()
+	loadRequests()
+	loadSchedule()
+	loadEarnings()
+
+	screen := NewScreen(container.NewVBox(
+		title,
+		availabilityCheck,
+		widget.NewSeparator(),
+		earningsLabel,
+		earningsRow,
+		widget.NewSeparator(),
+		requestsLabel,
+		requestsContainer,
+		widget.NewSeparator(),
+		scheduleLabel,
+		scheduleContainer,
+	))
+	// Requests, the schedule and earnings change while the worker is away
+	screen.ShowFunc = func() {
+		if screen.Revisited() {
+			loadAvailability()
+			loadRequests()
+			loadSchedule()
+			loadEarnings()
+		}
+	}
+	return screen
+}
+
+// createJobRequestCard shows an incoming request with accept/decline actions.
+// onResponded is called after the worker answered so lists can reload.
+func createJobRequestCard(state AppState, order Order, onResponded func()) fyne.CanvasObject {
+	clientLabel := newLabel(order.ClientName)
+	clientLabel.TextStyle = fyne.TextStyle{Bold: true}
+
+	details := newLabel(order.Category + " • " +
+		i18n.FormatDate(order.ScheduledAt.In(schedule.Location()), "Mon 02 Jan 15:04") + " • " +
+		i18n.T("%dh", order.Hours) +
+		"\n📍 " + order.Address)
+	details.Wrapping = fyne.TextWrapWord
+
+	var acceptBtn, declineBtn *widget.Button
+	respond := func(accept bool) {
+		acceptBtn.Disable()
+		declineBtn.Disable()
+		go func() {
+			err := RespondToJob(DefaultAPIConfig(), order.ID, accept)
+			fyne.Do(func() {
+				if err != nil {
+					acceptBtn.Enable()
+					declineBtn.Enable()
+					state.ShowConnectionError(StatusForError(err))
+					return
+				}
+				onResponded()
+			})
+		}()
+	}
+
+	acceptBtn = widget.NewButton(i18n.T("Accept"), func() { respond(true) })
+	acceptBtn.Importance = widget.SuccessImportance
+	declineBtn = widget.NewButton(i18n.T("Decline"), func() { respond(false) })
+	declineBtn.Importance = widget.DangerImportance
+
+	return container.NewVBox(
+		newBorderRow(nil, nil, nil, newLabel(order.Total.String()), clientLabel),
+		details,
+		container.NewGridWithColumns(2, declineBtn, acceptBtn),
+		widget.NewSeparator(),
+	)
+}
+
+// createScheduleRow shows one job with its date and time in Tunis time,
+// on the dashboard and the jobs tab
+func createScheduleRow(order Order) fyne.CanvasObject {
+	timeLabel := newLabel(i18n.FormatDate(order.ScheduledAt.In(schedule.Location()), "Mon 02 Jan\n15:04"))
+	timeLabel.TextStyle = fyne.TextStyle{Bold: true}
+
+	info := newLabel(i18n.T("%s (%dh)", order.ClientName, order.Hours) + "\n📍 " + order.Address)
+	info.Wrapping = fyne.TextWrapWord
+
+	return newBorderRow(nil, nil, timeLabel, nil, info)
+}
+
+// createWorkerJobsContent lists all of the worker's jobs on the jobs tab
+func createWorkerJobsContent(state AppState) fyne.CanvasObject {
+	title := newLabel(i18n.T("My Jobs"))
+	title.TextStyle = fyne.TextStyle{Bold: true}
+	title.Alignment = fyne.TextAlignCenter
+
+	jobsContainer := container.NewVBox(newLabel(i18n.T("Loading...")))
+
+	load := func() {
+		go func() {
+			orders, err := FetchWorkerJobs(DefaultAPIConfig(), "", time.Time{})
+			fyne.Do(func() {
+				jobsContainer.Objects = nil
+				if err != nil {
+					state.ShowConnectionError(StatusForError(err))
+				}
+				if len(orders) == 0 {
+					noJobs := newLabel(i18n.T("No jobs yet"))
+					noJobs.Alignment = fyne.TextAlignCenter
+					jobsContainer.Add(noJobs)
+				}
+				for _, order := range orders {
+					status := newLabel(order.Status.Label())
+					jobsContainer.Add(newBorderRow(nil, nil, nil, status, createScheduleRow(order)))
+				}
+				jobsContainer.Refresh()
+			})
+		}()
+	}
+	load()
+
+	screen := NewScreen(container.NewVBox(
+		title,
+		jobsContainer,
+		layout.NewSpacer(),
+	))
+	screen.ShowFunc = func() {
+		if screen.Revisited() {
+			load()
+		}
+	}
+	return screen
+}
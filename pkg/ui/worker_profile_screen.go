@@ -11,65 +11,114 @@ import (
 	"fyne.io/fyne/v2/theme"
 	"fyne.io/fyne/v2/widget"
 
+	"skillDar/pkg/i18n"
+	"skillDar/pkg/money"
+	"skillDar/pkg/schedule"
 	skilltheme "skillDar/pkg/theme"
 )
 
 // WorkerProfile represents a worker's profile data
 type WorkerProfile struct {
-	Name            string
-	Profession      string
-	Rating          float32
-	ReviewCount     int
-	Distance        string
-	HourlyRate      int
-	CompletedJobs   int
-	YearsExperience int
-	Available       bool
-	About           string
-	Skills          []string
+	ID              string      `json:"id"`
+	Name            string      `json:"name"`
+	AvatarURL       string      `json:"avatar_url,omitempty"`
+	Profession      string      `json:"profession"`
+	Rating          float32     `json:"rating"`
+	ReviewCount     int         `json:"review_count"`
+	Distance        string      `json:"distance"`
+	HourlyRate      money.Money `json:"hourly_rate"`
+	CompletedJobs   int         `json:"completed_jobs"`
+	YearsExperience int         `json:"years_experience"`
+	Available       bool        `json:"available"`
+	About           string      `json:"about"`
+	Skills          []string    `json:"skills"`
+
+	Verified         bool `json:"verified"`          // Identity confirmed by the backend
+	CertificateCount int  `json:"certificate_count"` // Approved trade certificates
+
+	MinimumHours int              `json:"minimum_hours"`
+	Portfolio    []PortfolioPhoto `json:"portfolio,omitempty"`
+	ServiceArea  *ServiceArea     `json:"service_area,omitempty"`
+
+	Schedule *schedule.Schedule `json:"schedule,omitempty"` // Working hours, nil if not shared
+	Bookings []schedule.Booking `json:"bookings,omitempty"` // Busy periods from existing jobs
+}
+
+// PortfolioPhoto is a picture of a worker's past job
+type PortfolioPhoto struct {
+	ID      string `json:"id"`
+	URL     string `json:"url"`
+	Caption string `json:"caption,omitempty"`
+}
+
+// ServiceArea is the zone a worker is willing to travel to
+type ServiceArea struct {
+	Center   LatLon  `json:"center"`
+	RadiusKm float64 `json:"radius_km"`
+}
+
+// IsAvailableNow reports whether the worker can take a one-hour job right now.
+// Workers without a published schedule fall back to the Available flag.
+func (w WorkerProfile) IsAvailableNow() bool {
+	if w.Schedule == nil {
+		return w.Available
+	}
+	return w.Available && w.Schedule.IsAvailable(time.Now(), time.Hour, w.Bookings)
+}
+
+// ratingSummary shows a worker's rating, review count and distance, e.g.
+// "⭐ 4.9  (127 reviews)  📍 0.8 km", with the distance in the unit
+func ratingSummary(worker WorkerProfile, unit DistanceUnit) string {
+	text := "⭐ " + i18n.FormatDecimal(float64(worker.Rating), 1) +
+		"  (" + i18n.N("%d review", "%d reviews", worker.ReviewCount, worker.ReviewCount) + ")"
+	if worker.Distance != "" {
+		text += "  📍 " + formatDistanceText(worker.Distance, unit)
+	}
+	return text
 }
 
 // CreateWorkerProfileScreen builds a detailed worker profile screen
 func CreateWorkerProfileScreen(state AppState, worker WorkerProfile) fyne.CanvasObject {
 	// Create blue header background
-	headerBg := canvas.NewRectangle(theme.Color(theme.ColorNamePrimary))
+	headerBg := skilltheme.NewThemedRectangle(theme.ColorNamePrimary)
 	headerBg.SetMinSize(fyne.NewSize(0, 160))
 
 	// Back button
-	backBtn := widget.NewButton("←", func() {
-		state.ShowScreen("main")
+	backBtn := widget.NewButtonWithIcon("", BackIcon(), func() {
+		state.GoBack()
 	})
 	backBtn.Importance = widget.LowImportance
 
 	// Verified badge
-	verifiedBadge := widget.NewLabel("✓ Verified")
+	verifiedBadge := skilltheme.NewThemedText("✓ "+i18n.T("Verified"), theme.ColorNameForegroundOnPrimary)
 	verifiedBadge.TextStyle = fyne.TextStyle{Bold: true}
+	if !worker.Verified {
+		verifiedBadge.Hide()
+	}
 
-	topBar := container.NewBorder(nil, nil, backBtn, verifiedBadge)
+	// Favorite toggle
+	subs := &Subscriptions{}
+	favoriteBtn := newFavoriteButton(state, worker, subs)
 
-	// Profile picture (circular)
-	profileCircle := canvas.NewCircle(theme.Color(skilltheme.ColorNameHighlight))
-	profileCircle.StrokeColor = theme.Color(theme.ColorNameBackground)
-	profileCircle.StrokeWidth = 4
+	topBar := newBorderRow(nil, nil, backBtn, newRow(verifiedBadge, favoriteBtn))
 
-	profilePicContainer := container.NewStack(profileCircle)
-	profilePicContainer.Resize(fyne.NewSize(100, 40))
+	// Profile picture (circular)
+	profilePicContainer := workerAvatar(state, worker, 72, subs)
 
 	// Worker name
-	nameLabel := canvas.NewText(worker.Name, theme.Color(theme.ColorNameBackground))
+	nameLabel := skilltheme.NewThemedText(worker.Name, theme.ColorNameForegroundOnPrimary)
 	nameLabel.Alignment = fyne.TextAlignCenter
-	nameLabel.TextSize = 20
+	nameLabel.SizeName = skilltheme.SizeNameTitleText
 	nameLabel.TextStyle = fyne.TextStyle{Bold: true}
 
 	// Profession
-	professionLabel := canvas.NewText(worker.Profession, theme.Color(theme.ColorNameBackground))
+	professionLabel := skilltheme.NewThemedText(worker.Profession, theme.ColorNameForegroundOnPrimary)
 	professionLabel.Alignment = fyne.TextAlignCenter
-	professionLabel.TextSize = 14
 
 	// Rating and distance info
-	ratingText := canvas.NewText("⭐ 4.9  (127 reviews)  📍 0.8 km", theme.Color(theme.ColorNameBackground))
+	ratingText := skilltheme.NewThemedText(ratingSummary(worker, state.Settings().DistanceUnit()), theme.ColorNameForegroundOnPrimary)
 	ratingText.Alignment = fyne.TextAlignCenter
-	ratingText.TextSize = 12
+	ratingText.SizeName = theme.SizeNameCaptionText
 
 	// Header content
 	headerContent := container.NewVBox(
@@ -85,9 +134,9 @@ func CreateWorkerProfileScreen(state AppState, worker WorkerProfile) fyne.Canvas
 	header := container.NewStack(headerBg, container.NewPadded(headerContent))
 
 	// Stats cards
-	completedStat := createStatCard2("📦", "340", "Completed")
-	experienceStat := createStatCard2("🏆", "12", "Years Exp.")
-	ratingStat := createStatCard2("⭐", "4.9", "Rating")
+	completedStat := createStatCard2("📦", i18n.FormatNumber(int64(worker.CompletedJobs)), i18n.T("Completed"))
+	experienceStat := createStatCard2("🏆", i18n.FormatNumber(int64(worker.YearsExperience)), i18n.T("Years Exp."))
+	ratingStat := createStatCard2("⭐", i18n.FormatDecimal(float64(worker.Rating), 1), i18n.T("Rating"))
 
 	statsRow := container.NewGridWithColumns(3,
 		completedStat,
@@ -96,23 +145,25 @@ func CreateWorkerProfileScreen(state AppState, worker WorkerProfile) fyne.Canvas
 	)
 
 	// Action buttons
-	callBtn := createRoundActionButton("📞", "Call", theme.Color(theme.ColorNameBackground))
-	chatBtn := createRoundActionButton("💬", "Chat", theme.Color(theme.ColorNameBackground))
-	hireBtn := createRoundActionButton("📅", "Hire", theme.Color(theme.ColorNameBackground))
+	callBtn := createRoundActionButton("📞", i18n.T("Call"), theme.ColorNameBackground, nil)
+	chatBtn := createRoundActionButton("💬", i18n.T("Chat"), theme.ColorNameBackground, nil)
+	hireBtn := createRoundActionButton("📅", i18n.T("Hire"), theme.ColorNameBackground, func() {
+		state.ShowBooking(worker)
+	})
 
 	actionsRow := container.NewGridWithColumns(3, callBtn, chatBtn, hireBtn)
 
 	// Price section
-	priceCard := createPriceCard(worker.HourlyRate)
+	priceCard := createPriceCard(worker.HourlyRate, worker.MinimumHours)
 
 	// About section content
-	aboutTitle := widget.NewLabel("Summary")
+	aboutTitle := newLabel(i18n.T("Summary"))
 	aboutTitle.TextStyle = fyne.TextStyle{Bold: true}
 
-	aboutText := widget.NewLabel("Available Now for Booking ✓")
+	aboutText := newLabel(i18n.T("Available Now for Booking") + " ✓")
 	aboutText.Importance = widget.SuccessImportance
 
-	description := widget.NewLabel("Professional installation and maintenance of electrical wiring, fixtures, and appliances. Repair services, installation of air conditioners and appliances.")
+	description := newLabel("Professional installation and maintenance of electrical wiring, fixtures, and appliances. Repair services, installation of air conditioners and appliances.")
 	description.Wrapping = fyne.TextWrapWord
 
 	aboutContent := container.NewVBox(
@@ -122,13 +173,27 @@ func CreateWorkerProfileScreen(state AppState, worker WorkerProfile) fyne.Canvas
 	)
 
 	// Skills section content
-	skillsContent := widget.NewLabel("• Electrical Wiring\n• Fixture Installation\n• Appliance Repair\n• Air Conditioner Installation\n• Maintenance Services")
+	skillsContent := newLabel("• Electrical Wiring\n• Fixture Installation\n• Appliance Repair\n• Air Conditioner Installation\n• Maintenance Services")
 	skillsContent.Wrapping = fyne.TextWrapWord
 
 	// Reviews section content
-	reviewsContent := widget.NewLabel("⭐⭐⭐⭐⭐\n\"Excellent work! Very professional.\"\n- Ahmed M.\n\n⭐⭐⭐⭐⭐\n\"Fixed my electrical issues quickly.\"\n- Sarah K.")
+	reviewsContent := newLabel("⭐⭐⭐⭐⭐\n\"Excellent work! Very professional.\"\n- Ahmed M.\n\n⭐⭐⭐⭐⭐\n\"Fixed my electrical issues quickly.\"\n- Sarah K.")
 	reviewsContent.Wrapping = fyne.TextWrapWord
 
+	// Portfolio section
+	portfolioContent := container.NewVBox()
+	if len(worker.Portfolio) > 0 {
+		portfolioTitle := newLabel(i18n.T("Portfolio"))
+		portfolioTitle.TextStyle = fyne.TextStyle{Bold: true}
+		photos := container.NewGridWrap(fyne.NewSize(110, 110))
+		for _, photo := range worker.Portfolio {
+			photos.Add(NewRemoteImage(photo.URL, fyne.NewSize(110, 110), false))
+		}
+		portfolioContent.Add(widget.NewSeparator())
+		portfolioContent.Add(portfolioTitle)
+		portfolioContent.Add(photos)
+	}
+
 	// Tab content container
 	tabContentContainer := container.NewStack()
 	switchTab := func(tabIndex int) {
@@ -147,9 +212,9 @@ func CreateWorkerProfileScreen(state AppState, worker WorkerProfile) fyne.Canvas
 	}
 
 	// Tab buttons
-	tab1Btn := widget.NewButton("About", func() { switchTab(0) })
-	tab2Btn := widget.NewButton("Skills", func() { switchTab(1) })
-	tab3Btn := widget.NewButton("Reviews", func() { switchTab(2) })
+	tab1Btn := widget.NewButton(i18n.T("About"), func() { switchTab(0) })
+	tab2Btn := widget.NewButton(i18n.T("Skills"), func() { switchTab(1) })
+	tab3Btn := widget.NewButton(i18n.T("Reviews"), func() { switchTab(2) })
 
 	tabsRow := container.NewGridWithColumns(3, tab1Btn, tab2Btn, tab3Btn)
 
@@ -166,31 +231,32 @@ func CreateWorkerProfileScreen(state AppState, worker WorkerProfile) fyne.Canvas
 		tabsRow,
 		widget.NewSeparator(),
 		tabContentContainer,
+		portfolioContent,
 	)
 
 	// Full layout
-	mainLayout := container.NewBorder(
+	screen := NewScreen(container.NewBorder(
 		header,
 		nil,
 		nil,
 		nil,
 		container.NewVScroll(content),
-	)
-
-	return mainLayout
+	))
+	screen.DestroyFunc = subs.Release
+	return screen
 }
 
 // createStatCard2 creates a stat card for the profile screen
 func createStatCard2(icon, value, label string) fyne.CanvasObject {
-	iconLabel := widget.NewLabel(icon)
+	iconLabel := newLabel(icon)
 	iconLabel.Alignment = fyne.TextAlignCenter
 	iconLabel.TextStyle = fyne.TextStyle{Bold: true}
 
-	valueLabel := widget.NewLabel(value)
+	valueLabel := newLabel(value)
 	valueLabel.Alignment = fyne.TextAlignCenter
 	valueLabel.TextStyle = fyne.TextStyle{Bold: true}
 
-	textLabel := widget.NewLabel(label)
+	textLabel := newLabel(label)
 	textLabel.Alignment = fyne.TextAlignCenter
 
 	content := container.NewVBox(
@@ -199,32 +265,32 @@ func createStatCard2(icon, value, label string) fyne.CanvasObject {
 		textLabel,
 	)
 
-	bg := canvas.NewRectangle(theme.Color(theme.ColorNameBackground))
+	bg := skilltheme.NewThemedRectangle(theme.ColorNameBackground)
+	bg.RadiusName = skilltheme.SizeNameRadiusCard
 
 	card := container.NewStack(bg, container.NewPadded(content))
 	return card
 }
 
 // createRoundActionButton creates a rounded action button
-func createRoundActionButton(icon, label string, bgColor color.Color) fyne.CanvasObject {
+// onAction is optional and runs after the tap feedback
+func createRoundActionButton(icon, label string, bgColor fyne.ThemeColorName, onAction func()) fyne.CanvasObject {
 	// Set text color based on background - white for primary (blue), dark for others
-	var textColor color.Color
-	if bgColor == theme.Color(theme.ColorNamePrimary) {
-		textColor = theme.Color(theme.ColorNameBackground) // White text on blue background
-	} else {
-		textColor = theme.Color(theme.ColorNameForeground) // Dark text on light background
+	textColor := theme.ColorNameForeground // Dark text on light background
+	if bgColor == theme.ColorNamePrimary {
+		textColor = theme.ColorNameForegroundOnPrimary // White text on blue background
 	}
 
-	bg := canvas.NewCircle(bgColor)
+	bg := skilltheme.NewThemedCircle(bgColor)
 
 	// Create text overlays with proper colors
-	iconText := canvas.NewText(icon, textColor)
+	iconText := skilltheme.NewThemedText(icon, textColor)
 	iconText.Alignment = fyne.TextAlignCenter
-	iconText.TextSize = 20
+	iconText.SizeName = skilltheme.SizeNameTitleText
 
-	labelText := canvas.NewText(label, textColor)
+	labelText := skilltheme.NewThemedText(label, textColor)
 	labelText.Alignment = fyne.TextAlignCenter
-	labelText.TextSize = 12
+	labelText.SizeName = theme.SizeNameCaptionText
 
 	textContent := container.NewVBox(
 		layout.NewSpacer(),
@@ -240,12 +306,14 @@ func createRoundActionButton(icon, label string, bgColor color.Color) fyne.Canva
 	btn := &tappableContainer{
 		content: card,
 		bg:      bg,
-		bgColor: bgColor,
 		onTap: func() {
 			// Handle action based on label
 			println("===============================")
 			println("BUTTON TAPPED:", label)
 			println("===============================")
+			if onAction != nil {
+				onAction()
+			}
 		},
 	}
 	btn.ExtendBaseWidget(btn)
@@ -257,8 +325,7 @@ func createRoundActionButton(icon, label string, bgColor color.Color) fyne.Canva
 type tappableContainer struct {
 	widget.BaseWidget
 	content fyne.CanvasObject
-	bg      *canvas.Circle
-	bgColor color.Color
+	bg      *skilltheme.ThemedCircle
 	onTap   func()
 }
 
@@ -270,22 +337,17 @@ func (t *tappableContainer) Tapped(*fyne.PointEvent) {
 	if t.onTap != nil {
 		// Visual feedback: show gray background briefly for all buttons
 		if t.bg != nil {
-			// Save original color
-			originalColor := t.bgColor
-
 			// Use gray for tap feedback (visible in both light and dark themes)
-			tapColor := color.RGBA{R: 180, G: 180, B: 180, A: 255}
-
-			t.bg.FillColor = tapColor
-			canvas.Refresh(t.bg)
+			t.bg.Override = color.RGBA{R: 180, G: 180, B: 180, A: 255}
+			t.bg.Refresh()
 
 			// Restore original color after a short delay
 			go func() {
 				time.Sleep(100 * time.Millisecond)
 				// Use fyne.Do to safely update UI from goroutine
 				fyne.Do(func() {
-					t.bg.FillColor = originalColor
-					canvas.Refresh(t.bg)
+					t.bg.Override = nil
+					t.bg.Refresh()
 				})
 			}()
 		}
@@ -317,21 +379,24 @@ func (r *tappableRenderer) Objects() []fyne.CanvasObject {
 func (r *tappableRenderer) Destroy() {}
 
 // createPriceCard creates the pricing information card
-func createPriceCard(hourlyRate int) fyne.CanvasObject {
-	priceTitle := widget.NewLabel("Travel Price")
+func createPriceCard(hourlyRate money.Money, minimumHours int) fyne.CanvasObject {
+	priceTitle := newLabel(i18n.T("Travel Price"))
 	priceTitle.Alignment = fyne.TextAlignCenter
 
 	// Large price display
-	priceText := canvas.NewText("TND 180", theme.Color(theme.ColorNameForeground))
+	priceText := skilltheme.NewThemedText(hourlyRate.Compact(money.Locale()), theme.ColorNameForeground)
 	priceText.Alignment = fyne.TextAlignCenter
-	priceText.TextSize = 28
+	priceText.SizeName = skilltheme.SizeNameDisplayText
 	priceText.TextStyle = fyne.TextStyle{Bold: true}
 
-	perHourLabel := widget.NewLabel("Per Hour")
+	perHourLabel := newLabel(i18n.T("Per Hour"))
 	perHourLabel.Alignment = fyne.TextAlignCenter
 
-	minLabel := widget.NewLabel("(Minimum 2 hours)")
+	minLabel := newLabel(i18n.N("(Minimum %d hour)", "(Minimum %d hours)", minimumHours, minimumHours))
 	minLabel.Alignment = fyne.TextAlignCenter
+	if minimumHours <= 1 {
+		minLabel.Hide()
+	}
 
 	content := container.NewVBox(
 		priceTitle,
@@ -341,20 +406,21 @@ func createPriceCard(hourlyRate int) fyne.CanvasObject {
 	)
 
 	// Light beige/yellow background
-	bg := canvas.NewRectangle(theme.Color(skilltheme.ColorNameHighlight))
+	bg := skilltheme.NewThemedRectangle(skilltheme.ColorNameHighlight)
+	bg.RadiusName = skilltheme.SizeNameRadiusCard
 
 	return container.NewStack(bg, container.NewPadded(content))
 }
 
 // createTab creates a tab button
 func createTab(label string, active bool) fyne.CanvasObject {
-	tabLabel := widget.NewLabel(label)
+	tabLabel := newLabel(label)
 	tabLabel.Alignment = fyne.TextAlignCenter
 
 	if active {
 		tabLabel.TextStyle = fyne.TextStyle{Bold: true}
 		// Add underline indicator
-		underline := canvas.NewRectangle(theme.Color(theme.ColorNamePrimary))
+		underline := skilltheme.NewThemedRectangle(theme.ColorNamePrimary)
 		underline.SetMinSize(fyne.NewSize(0, 2))
 
 		return container.NewBorder(nil, underline, nil, nil, container.NewPadded(tabLabel))
@@ -0,0 +1,35 @@
+package ui
+
+import (
+	"testing"
+
+	"fyne.io/fyne/v2/test"
+)
+
+func TestRatingSummaryDistanceUnit(t *testing.T) {
+	settings := NewSettingsStore(test.NewTempApp(t))
+	worker := WorkerProfile{Rating: 4.8, ReviewCount: 98, Distance: "3.2 km"}
+
+	if got, want := ratingSummary(worker, settings.DistanceUnit()), "⭐ 4.8  (98 reviews)  📍 3.2 km"; got != want {
+		t.Errorf("in kilometres = %q, want %q", got, want)
+	}
+	settings.SetDistanceUnit(Miles)
+	if got, want := ratingSummary(worker, settings.DistanceUnit()), "⭐ 4.8  (98 reviews)  📍 2.0 mi"; got != want {
+		t.Errorf("in miles = %q, want %q", got, want)
+	}
+
+	tests := []struct {
+		distance string
+		want     string
+	}{
+		{"", "⭐ 4.8  (98 reviews)"},                 // Own profile, no distance
+		{"nearby", "⭐ 4.8  (98 reviews)  📍 nearby"}, // Not understood, shown as sent
+		{"0.5 km", "⭐ 4.8  (98 reviews)  📍 0.3 mi"},
+	}
+	for _, tt := range tests {
+		worker.Distance = tt.distance
+		if got := ratingSummary(worker, Miles); got != tt.want {
+			t.Errorf("distance %q = %q, want %q", tt.distance, got, tt.want)
+		}
+	}
+}